@@ -22,10 +22,13 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/arizonahanson/oryx/pkg/eval"
 	"github.com/arizonahanson/oryx/pkg/lib"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -34,6 +37,8 @@ import (
 var (
 	version string
 	cfgFile string
+	// cancels the running script
+	ctx, cancel = context.WithCancel(context.Background())
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -44,7 +49,24 @@ var rootCmd = &cobra.Command{
 	Args:    cobra.ExactArgs(1),
 	Version: version,
 	Run: func(cmd *cobra.Command, args []string) {
-		val, err := lib.DoFile(args[0], nil)
+		num, err := numeric()
+		cobra.CheckErr(err)
+		env := eval.NewEnv(nil)
+		env.SetNumeric(num)
+		env.SetContext(cmd.Context())
+		val, err := lib.DoFile(args[0], env)
+		var diags eval.Diagnostics
+		if errors.As(err, &diags) {
+			// render syntax errors with source snippets
+			fmt.Fprint(os.Stderr, diags.Render())
+			os.Exit(1)
+		}
+		var trace *eval.Error
+		if errors.As(err, &trace) {
+			// render runtime errors as a traceback
+			fmt.Fprint(os.Stderr, trace.Trace(args[0]))
+			os.Exit(1)
+		}
 		cobra.CheckErr(err)
 		fmt.Println(val)
 	},
@@ -53,7 +75,7 @@ var rootCmd = &cobra.Command{
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -67,6 +89,12 @@ func init() {
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	viper.SetConfigType("toml")
+	// numeric context defaults
+	viper.SetDefault("numeric.precision", eval.DefaultNumeric.Precision)
+	viper.SetDefault("numeric.rounding", eval.DefaultNumeric.Rounding.String())
+	viper.SetDefault("numeric.max-digits", eval.DefaultNumeric.MaxDigits)
+	viper.SetDefault("numeric.overflow", eval.DefaultNumeric.Overflow.String())
+	viper.SetDefault("numeric.exact", eval.DefaultNumeric.Exact)
 	if cfgFile == "" {
 		// Find home directory.
 		home, err := os.UserHomeDir()
@@ -100,6 +128,27 @@ func initConfig() {
 	viper.SetTypeByDefaultValue(true)
 }
 
+// numeric context from the config
+func numeric() (eval.Numeric, error) {
+	precision, maxDigits := viper.GetInt64("numeric.precision"), viper.GetInt64("numeric.max-digits")
+	if precision < 0 || maxDigits < 0 || precision > eval.MaxPrecision || maxDigits > eval.MaxPrecision {
+		return eval.DefaultNumeric, fmt.Errorf("numeric precision and max-digits must be in [0, %d]", eval.MaxPrecision)
+	}
+	num := eval.Numeric{
+		Precision: int32(precision),
+		MaxDigits: int32(maxDigits),
+		Exact:     viper.GetBool("numeric.exact"),
+	}
+	var err error
+	num.Rounding, err = eval.ParseRounding(viper.GetString("numeric.rounding"))
+	if err != nil {
+		return num, err
+	}
+	num.Overflow, err = eval.ParseOverflow(viper.GetString("numeric.overflow"))
+	return num, err
+}
+
+// stop the running script with a cancelled error
 func Quit() {
-	os.Exit(0)
+	cancel()
 }
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+// syntax error at a position
+type Failure struct {
+	Pos     ast.Position
+	Message string
+}
+
+// failures of a parse, in source order without duplicates
+func Failures(err error) []Failure {
+	var list errList
+	if !errors.As(err, &list) {
+		return nil
+	}
+	seen := map[Failure]bool{}
+	res := []Failure{}
+	for _, item := range list {
+		var pe *parserError
+		if !errors.As(item, &pe) {
+			return nil
+		}
+		fail := Failure{*pos(pe.pos), pe.Inner.Error()}
+		if !seen[fail] {
+			seen[fail] = true
+			res = append(res, fail)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Pos.Offset < res[j].Pos.Offset
+	})
+	return res
+}
+
+// closing characters by form
+var closers = map[string]string{
+	"string":     `"`,
+	"array":      "]",
+	"map":        "}",
+	"expression": ")",
+}
+
+// error for a missing closing character
+func unterminated(form string) error {
+	return fmt.Errorf("%s not terminated, wanted %q", form, closers[form])
+}
+
+// error for skipped text
+func unexpected(c *current) error {
+	text := []rune(string(c.text))
+	if len(text) > 16 {
+		return fmt.Errorf("unexpected %q...", string(text[:16]))
+	}
+	return fmt.Errorf("unexpected %q", string(text))
+}
+
+func malformed(c *current, want string) error {
+	return fmt.Errorf("malformed number %q, wanted %s", string(c.text), want)
+}
@@ -1,9 +1,20 @@
 package parser
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
+
 	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/shopspring/decimal"
 )
 
+// parsed value with its source span
+type node struct {
+	val  ast.Any
+	span *ast.Span
+}
+
 // cast to []interface{}
 func slice(v interface{}) []interface{} {
 	if v == nil {
@@ -12,86 +23,364 @@ func slice(v interface{}) []interface{} {
 	return v.([]interface{})
 }
 
-// build []ast.Any from first, rest=[[_, next], ...]
-func join(first, rest interface{}, index int) []ast.Any {
-	if first == nil {
-		return []ast.Any{}
+// root value, spans are recorded when a table is in the global store
+func root(c *current, expr interface{}) (ast.Any, error) {
+	if expr == nil {
+		return ast.Null{}, nil
+	}
+	n := expr.(node)
+	if spans, ok := c.globalStore["spans"].(*ast.Spans); ok {
+		spans.Index(n.val, n.span)
+	}
+	return n.val, nil
+}
+
+// node of the matched text
+func leaf(c *current, val ast.Any) node {
+	return node{val, span(c)}
+}
+
+// node of the matched text, keeping the children of n
+func wrap(c *current, n node) node {
+	res := *n.span
+	outer := span(c)
+	res.Start, res.End = outer.Start, outer.End
+	return node{n.val, &res}
+}
+
+// span of the matched text
+func span(c *current) *ast.Span {
+	start := pos(c.pos)
+	end := *start
+	// column of the last rune
+	end.Column--
+	for _, r := range string(c.text) {
+		if r == '\n' {
+			end.Row++
+			end.Column = 0
+		} else {
+			end.Column++
+		}
 	}
+	// exclusive end
+	end.Column++
+	end.Offset += int64(len(c.text))
+	return &ast.Span{Start: *start, End: end}
+}
+
+// split nodes into values and spans
+func unzip(list []node) ([]ast.Any, []*ast.Span) {
+	vals := make([]ast.Any, len(list))
+	spans := make([]*ast.Span, len(list))
+	for i, item := range list {
+		vals[i] = item.val
+		spans[i] = item.span
+	}
+	return vals, spans
+}
+
+// span covering all nodes
+func cover(list []node) *ast.Span {
+	res := &ast.Span{Start: list[0].span.Start, End: list[0].span.End}
+	for _, item := range list[1:] {
+		if item.span.Start.Offset < res.Start.Offset {
+			res.Start = item.span.Start
+		}
+		if item.span.End.Offset > res.End.Offset {
+			res.End = item.span.End
+		}
+	}
+	return res
+}
+
+// array of nodes
+func array(list []node) node {
+	vals, spans := unzip(list)
+	res := cover(list)
+	res.Items = spans
+	return node{ast.Array(vals), res}
+}
+
+// expression of nodes
+func sexpr(list []node) node {
+	vals, spans := unzip(list)
+	res := cover(list)
+	res.Items = spans
+	return node{ast.Expr(vals), res}
+}
+
+// build []node from first, rest=[[_, next], ...], skipping empty items
+func join(first, rest interface{}, index int) []node {
 	more := slice(rest)
-	result := make([]ast.Any, len(more)+1)
-	result[0] = first.(ast.Any)
-	for i, group := range more {
-		next := slice(group)[index]
-		result[i+1] = next.(ast.Any)
+	result := make([]node, 0, len(more)+1)
+	if first != nil {
+		result = append(result, first.(node))
+	}
+	for _, group := range more {
+		if next := slice(group)[index]; next != nil {
+			result = append(result, next.(node))
+		}
 	}
 	return result
 }
 
-func swap(first, rest interface{}, opIndex int, rightIndex int) []ast.Any {
+func swap(first, rest interface{}, opIndex int, rightIndex int) []node {
 	// return left if no right
 	more := slice(rest)
-	left := first.([]ast.Any)
+	left := first.([]node)
 	if len(more) == 0 || more[0] == nil {
 		return left
 	}
 	// iterate right side
-	result := make([]ast.Any, len(more)+2)
+	result := make([]node, len(more)+2)
 	i := 0
 	for _, group := range more {
 		frag := slice(group)
-		op := frag[opIndex].(ast.Symbol)
-		right := frag[rightIndex].([]ast.Any)
+		op := frag[opIndex].(node)
+		right := frag[rightIndex].([]node)
 		if i == 0 {
 			result[0] = op
-			if len(left) > 1 {
-				result[1] = ast.Expr(left)
-			} else {
-				result[1] = left[0]
-			}
+			result[1] = single(left)
 		}
-		if !op.Equal(result[0]) {
+		if !op.val.Equal(result[0].val) {
 			// new op
-			newexpr := make([]ast.Any, len(result)-i)
+			newexpr := make([]node, len(result)-i)
 			newexpr[0] = op
-			newexpr[1] = ast.Expr(result[:i+2])
+			newexpr[1] = sexpr(result[:i+2])
 			result = newexpr
 			i = 0
 		}
-		if len(right) > 1 {
-			result[i+2] = ast.Expr(right)
-		} else {
-			result[i+2] = right[0]
-		}
+		result[i+2] = single(right)
 		i++
 	}
 	return result
 }
 
-func merge(first, rest interface{}, keyIndex int, valueIndex int) map[ast.String]ast.Any {
-	pair := slice(first)
-	if pair == nil {
-		return map[ast.String]ast.Any{}
+// thread left through the stages of right=[[_, op, _, stage], ...]
+func pipe(first, rest interface{}, opIndex, stageIndex int) []node {
+	acc := single(first.([]node))
+	var call []node
+	for i, group := range slice(rest) {
+		frag := slice(group)
+		op := frag[opIndex].(node)
+		items := frag[stageIndex].([]node)
+		// a parenthesized call takes the arg too (eg. xs |> (get 0)),
+		// but a function expression is called with it (eg. x |> ([y] => y))
+		if len(items) == 1 {
+			if exp, ok := items[0].val.(ast.Expr); ok && len(exp) > 0 && !isFn(exp) {
+				items = split(items[0])
+			}
+		}
+		if i > 0 {
+			acc = sexpr(call)
+		}
+		if op.val.(ast.Symbol).Val == "|>>" {
+			call = append(append([]node{}, items...), acc)
+		} else {
+			call = append([]node{items[0], acc}, items[1:]...)
+		}
+	}
+	return call
+}
+
+// expression building a function, eg. ([x] => x) or (func [x] x)
+func isFn(exp ast.Expr) bool {
+	sym, ok := exp[0].(ast.Symbol)
+	return ok && (sym.Val == "=>" || sym.Val == "func")
+}
+
+// items of an expression node
+func split(n node) []node {
+	exp := n.val.(ast.Expr)
+	res := make([]node, len(exp))
+	for i, item := range exp {
+		res[i] = node{item, n.span.Items[i]}
 	}
-	more := slice(rest)
-	result := make(map[ast.String]ast.Any, len(more)+1)
+	return res
+}
+
+// build [op, cond, then, else] from left, right=[_, op, _, then, _, ':', !'=', _, else]
+func ternary(first, rest interface{}, opIndex, thenIndex, elseIndex int) []node {
+	left := first.([]node)
+	frag := slice(rest)
+	if frag == nil {
+		return left
+	}
+	op := frag[opIndex].(node)
+	then := frag[thenIndex].([]node)
+	other := frag[elseIndex].([]node)
+	return []node{op, single(left), single(then), single(other)}
+}
+
+// [op, base, exp], a negative literal base is negated after the power like -x ** 2
+func power(base, op, exp node) []node {
+	var abs ast.Any
+	switch num := base.val.(type) {
+	case ast.Number:
+		if num.Decimal().IsNegative() {
+			abs = ast.Number(num.Decimal().Neg())
+		}
+	case ast.Rational:
+		if rat := num.Rat(); rat.Sign() < 0 {
+			abs = ast.NewRational(rat.Neg(rat))
+		}
+	}
+	if abs == nil {
+		return []node{op, base, exp}
+	}
+	start := base.span.Start
+	neg := node{ast.Symbol{Val: "-", Pos: &start}, base.span}
+	return []node{neg, sexpr([]node{op, {abs, base.span}, exp})}
+}
+
+// single item, or expression of many
+func single(list []node) node {
+	if len(list) > 1 {
+		return sexpr(list)
+	}
+	return list[0]
+}
+
+func merge(c *current, first, rest interface{}, keyIndex int, valueIndex int) node {
+	result := ast.Map{}
+	res := span(c)
+	res.Entries = map[ast.String]*ast.Span{}
 	// assign helper
 	assign := func(keyval []interface{}, keyN int, valN int) {
-		key := keyval[keyN].(ast.String)
-		result[key] = keyval[valN].(ast.Any)
+		key := keyval[keyN].(node).val.(ast.String)
+		val := keyval[valN].(node)
+		result[key] = val.val
+		res.Entries[key] = val.span
 	}
 	// assign pairs
-	assign(pair, keyIndex, valueIndex)
-	for _, group := range more {
+	if pair := slice(first); pair != nil {
+		assign(pair, keyIndex, valueIndex)
+	}
+	for _, group := range slice(rest) {
 		pair := slice(group)
 		assign(pair, keyIndex+1, valueIndex+1)
 	}
-	return result
+	return node{result, res}
+}
+
+// build (name any) for a quote prefix
+func quote(c *current, name string, any interface{}) (node, error) {
+	quoted := any.(node)
+	res := span(c)
+	prefix := &ast.Span{Start: res.Start, End: quoted.span.Start}
+	res.Items = []*ast.Span{prefix, quoted.span}
+	val := ast.Expr{ast.Symbol{Val: name, Pos: pos(c.pos)}, quoted.val}
+	return node{val, res}, nil
+}
+
+// number in base 10, or an integer with a base prefix when base is 0
+func number(c *current, base int) (node, error) {
+	text := strings.ReplaceAll(string(c.text), "_", "")
+	if base == 10 {
+		num, err := ast.NewNumberFromString(strings.TrimPrefix(text, "+"))
+		return leaf(c, num), err
+	}
+	i, ok := new(big.Int).SetString(text, base)
+	if !ok {
+		return leaf(c, ast.Null{}), malformed(c, "digits")
+	}
+	return leaf(c, ast.Number(decimal.NewFromBigInt(i, 0))), nil
+}
+
+// rational, or integer number when the denominator divides
+func rational(c *current) (node, error) {
+	text := strings.ReplaceAll(string(c.text), "_", "")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "+"), "r")
+	rat, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return leaf(c, ast.Null{}), fmt.Errorf("malformed rational %q, wanted non-zero denominator", string(c.text))
+	}
+	if rat.IsInt() {
+		return leaf(c, ast.Number(decimal.NewFromBigInt(rat.Num(), 0))), nil
+	}
+	return leaf(c, ast.NewRational(rat)), nil
 }
 
-func symbol(c *current) (ast.Symbol, error) {
-	return ast.Symbol{Val: string(c.text), Pos: pos(c.pos)}, nil
+func symbol(c *current) (node, error) {
+	return leaf(c, ast.Symbol{Val: string(c.text), Pos: pos(c.pos)}), nil
 }
 
 func pos(p position) *ast.Position {
 	return &ast.Position{Row: int64(p.line), Column: int64(p.col), Offset: int64(p.offset)}
 }
+
+// literal part of a String, before unquoting
+type text node
+
+// String of literal parts, or (${} part...) when interpolated
+func interpolate(c *current, parts []interface{}) (node, error) {
+	literal := ""
+	list := []node{{ast.Symbol{Val: "${}", Pos: pos(c.pos)}, span(c)}}
+	for _, part := range parts {
+		switch n := part.(type) {
+		case text:
+			// unquote the escape-sequences of the raw text
+			str, err := ast.NewStringFromString(`"` + n.val.(ast.String).Val + `"`)
+			if err != nil {
+				return leaf(c, ast.Null{}), err
+			}
+			literal += str.Val
+			list = append(list, node{str, n.span})
+		case node:
+			list = append(list, unwrap(n))
+		}
+	}
+	if isLiteral(parts) {
+		return leaf(c, ast.String{Val: literal}), nil
+	}
+	vals, spans := unzip(list)
+	res := span(c)
+	res.Items = spans
+	return node{ast.Expr(vals), res}, nil
+}
+
+// item of an expression of one item
+func unwrap(n node) node {
+	if exp, ok := n.val.(ast.Expr); ok && len(exp) == 1 {
+		return node{exp[0], n.span.Items[0]}
+	}
+	return n
+}
+
+// builtin symbol for a postfix operator, at the matched text
+func operator(c *current, name string) node {
+	return node{ast.Symbol{Val: name, Pos: pos(c.pos)}, span(c)}
+}
+
+// slice bound, or null when omitted
+func bound(c *current, exp interface{}) node {
+	if exp == nil {
+		return leaf(c, ast.Null{})
+	}
+	return unwrap(exp.(node))
+}
+
+// apply suffixes=[[op, args...], ...] to any, eg. ([] (. m "a") 0)
+func postfix(any node, suffixes []interface{}) node {
+	res := any
+	for _, suffix := range suffixes {
+		ops := suffix.([]node)
+		list := append([]node{ops[0], res}, ops[1:]...)
+		res = sexpr(list)
+	}
+	return res
+}
+
+func isLiteral(parts []interface{}) bool {
+	for _, part := range parts {
+		if _, ok := part.(text); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// raw pattern of a regex literal
+func regex(text []byte) string {
+	return string(text[2 : len(text)-1])
+}
@@ -42,8 +42,57 @@ var g = &grammar{
 								},
 							},
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 8, col: 19, offset: 96},
+							expr: &seqExpr{
+								pos: position{line: 8, col: 20, offset: 97},
+								exprs: []interface{}{
+									&zeroOrMoreExpr{
+										pos: position{line: 8, col: 20, offset: 97},
+										expr: &ruleRefExpr{
+											pos:  position{line: 8, col: 20, offset: 97},
+											name: "_",
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 8, col: 24, offset: 101},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 8, col: 24, offset: 101},
+												name: "Stray",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 8, col: 32, offset: 109},
+												name: "Junk",
+											},
+										},
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 8, col: 38, offset: 115},
+										expr: &ruleRefExpr{
+											pos:  position{line: 8, col: 38, offset: 115},
+											name: "_",
+										},
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 8, col: 41, offset: 118},
+										expr: &ruleRefExpr{
+											pos:  position{line: 8, col: 41, offset: 118},
+											name: "Expr",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 8, col: 49, offset: 126},
+							expr: &ruleRefExpr{
+								pos:  position{line: 8, col: 49, offset: 126},
+								name: "_",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 8, col: 19, offset: 96},
+							pos:  position{line: 8, col: 52, offset: 129},
 							name: "EOF",
 						},
 					},
@@ -52,83 +101,131 @@ var g = &grammar{
 		},
 		{
 			name: "Any",
-			pos:  position{line: 13, col: 1, offset: 137},
+			pos:  position{line: 13, col: 1, offset: 174},
 			expr: &choiceExpr{
-				pos: position{line: 13, col: 8, offset: 146},
+				pos: position{line: 13, col: 8, offset: 183},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 8, offset: 146},
+						pos:  position{line: 13, col: 8, offset: 183},
 						name: "Null",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 15, offset: 153},
+						pos:  position{line: 13, col: 15, offset: 190},
 						name: "Boolean",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 25, offset: 163},
+						pos:  position{line: 13, col: 25, offset: 200},
+						name: "Rational",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 36, offset: 211},
 						name: "Number",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 34, offset: 172},
+						pos:  position{line: 13, col: 45, offset: 220},
 						name: "String",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 43, offset: 181},
+						pos:  position{line: 13, col: 54, offset: 229},
+						name: "Regex",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 62, offset: 237},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 51, offset: 189},
+						pos:  position{line: 13, col: 70, offset: 245},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 57, offset: 195},
+						pos:  position{line: 13, col: 76, offset: 251},
 						name: "Symbol",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 13, col: 66, offset: 204},
+						pos:  position{line: 13, col: 85, offset: 260},
 						name: "SExpr",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 93, offset: 268},
+						name: "Quoted",
+					},
 				},
 			},
 		},
 		{
 			name: "Null",
-			pos:  position{line: 16, col: 1, offset: 219},
+			pos:  position{line: 16, col: 1, offset: 284},
 			expr: &actionExpr{
-				pos: position{line: 16, col: 9, offset: 229},
+				pos: position{line: 16, col: 9, offset: 294},
 				run: (*parser).callonNull1,
-				expr: &litMatcher{
-					pos:        position{line: 16, col: 9, offset: 229},
-					val:        "null",
-					ignoreCase: false,
-					want:       "\"null\"",
+				expr: &seqExpr{
+					pos: position{line: 16, col: 9, offset: 294},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 16, col: 9, offset: 294},
+							val:        "null",
+							ignoreCase: false,
+							want:       "\"null\"",
+						},
+						&notExpr{
+							pos: position{line: 16, col: 16, offset: 301},
+							expr: &ruleRefExpr{
+								pos:  position{line: 16, col: 17, offset: 302},
+								name: "symChr",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "Boolean",
-			pos:  position{line: 21, col: 1, offset: 277},
+			pos:  position{line: 21, col: 1, offset: 359},
 			expr: &choiceExpr{
-				pos: position{line: 21, col: 12, offset: 290},
+				pos: position{line: 21, col: 12, offset: 372},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 21, col: 12, offset: 290},
+						pos: position{line: 21, col: 12, offset: 372},
 						run: (*parser).callonBoolean2,
-						expr: &litMatcher{
-							pos:        position{line: 21, col: 12, offset: 290},
-							val:        "true",
-							ignoreCase: false,
-							want:       "\"true\"",
+						expr: &seqExpr{
+							pos: position{line: 21, col: 12, offset: 372},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 21, col: 12, offset: 372},
+									val:        "true",
+									ignoreCase: false,
+									want:       "\"true\"",
+								},
+								&notExpr{
+									pos: position{line: 21, col: 19, offset: 379},
+									expr: &ruleRefExpr{
+										pos:  position{line: 21, col: 20, offset: 380},
+										name: "symChr",
+									},
+								},
+							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 23, col: 5, offset: 335},
-						run: (*parser).callonBoolean4,
-						expr: &litMatcher{
-							pos:        position{line: 23, col: 5, offset: 335},
-							val:        "false",
-							ignoreCase: false,
-							want:       "\"false\"",
+						pos: position{line: 23, col: 5, offset: 434},
+						run: (*parser).callonBoolean7,
+						expr: &seqExpr{
+							pos: position{line: 23, col: 5, offset: 434},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 23, col: 5, offset: 434},
+									val:        "false",
+									ignoreCase: false,
+									want:       "\"false\"",
+								},
+								&notExpr{
+									pos: position{line: 23, col: 13, offset: 442},
+									expr: &ruleRefExpr{
+										pos:  position{line: 23, col: 14, offset: 443},
+										name: "symChr",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -136,291 +233,2120 @@ var g = &grammar{
 		},
 		{
 			name: "Number",
-			pos:  position{line: 28, col: 1, offset: 414},
-			expr: &actionExpr{
-				pos: position{line: 28, col: 11, offset: 426},
-				run: (*parser).callonNumber1,
-				expr: &seqExpr{
-					pos: position{line: 28, col: 11, offset: 426},
-					exprs: []interface{}{
-						&zeroOrOneExpr{
-							pos: position{line: 28, col: 11, offset: 426},
-							expr: &litMatcher{
-								pos:        position{line: 28, col: 11, offset: 426},
-								val:        "-",
-								ignoreCase: false,
-								want:       "\"-\"",
-							},
-						},
-						&oneOrMoreExpr{
-							pos: position{line: 28, col: 16, offset: 431},
-							expr: &ruleRefExpr{
-								pos:  position{line: 28, col: 16, offset: 431},
-								name: "digit",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 28, col: 23, offset: 438},
-							expr: &seqExpr{
-								pos: position{line: 28, col: 24, offset: 439},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 28, col: 24, offset: 439},
-										val:        ".",
-										ignoreCase: false,
-										want:       "\".\"",
+			pos:  position{line: 28, col: 1, offset: 579},
+			expr: &choiceExpr{
+				pos: position{line: 28, col: 11, offset: 591},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 28, col: 11, offset: 591},
+						run: (*parser).callonNumber2,
+						expr: &seqExpr{
+							pos: position{line: 28, col: 11, offset: 591},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 28, col: 11, offset: 591},
+									expr: &ruleRefExpr{
+										pos:  position{line: 28, col: 11, offset: 591},
+										name: "sign",
 									},
-									&oneOrMoreExpr{
-										pos: position{line: 28, col: 28, offset: 443},
-										expr: &ruleRefExpr{
-											pos:  position{line: 28, col: 28, offset: 443},
-											name: "digit",
+								},
+								&choiceExpr{
+									pos: position{line: 28, col: 18, offset: 598},
+									alternatives: []interface{}{
+										&seqExpr{
+											pos: position{line: 28, col: 18, offset: 598},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 28, col: 18, offset: 598},
+													val:        "0x",
+													ignoreCase: true,
+													want:       "\"0x\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 28, col: 24, offset: 604},
+													name: "hexDigits",
+												},
+											},
+										},
+										&seqExpr{
+											pos: position{line: 28, col: 36, offset: 616},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 28, col: 36, offset: 616},
+													val:        "0b",
+													ignoreCase: true,
+													want:       "\"0b\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 28, col: 42, offset: 622},
+													name: "binDigits",
+												},
+											},
+										},
+										&seqExpr{
+											pos: position{line: 28, col: 54, offset: 634},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 28, col: 54, offset: 634},
+													val:        "0o",
+													ignoreCase: true,
+													want:       "\"0o\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 28, col: 60, offset: 640},
+													name: "octDigits",
+												},
+											},
 										},
 									},
 								},
+								&notExpr{
+									pos: position{line: 28, col: 71, offset: 651},
+									expr: &ruleRefExpr{
+										pos:  position{line: 28, col: 72, offset: 652},
+										name: "numChr",
+									},
+								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 28, col: 37, offset: 452},
-							expr: &seqExpr{
-								pos: position{line: 28, col: 38, offset: 453},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 28, col: 38, offset: 453},
-										val:        "e",
-										ignoreCase: true,
-										want:       "\"e\"i",
+					},
+					&actionExpr{
+						pos: position{line: 30, col: 5, offset: 687},
+						run: (*parser).callonNumber18,
+						expr: &seqExpr{
+							pos: position{line: 30, col: 5, offset: 687},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 30, col: 5, offset: 687},
+									expr: &ruleRefExpr{
+										pos:  position{line: 30, col: 5, offset: 687},
+										name: "sign",
 									},
-									&zeroOrOneExpr{
-										pos: position{line: 28, col: 43, offset: 458},
-										expr: &choiceExpr{
-											pos: position{line: 28, col: 44, offset: 459},
-											alternatives: []interface{}{
+								},
+								&ruleRefExpr{
+									pos:  position{line: 30, col: 11, offset: 693},
+									name: "digits",
+								},
+								&choiceExpr{
+									pos: position{line: 30, col: 19, offset: 701},
+									alternatives: []interface{}{
+										&seqExpr{
+											pos: position{line: 30, col: 19, offset: 701},
+											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 28, col: 44, offset: 459},
-													val:        "+",
+													pos:        position{line: 30, col: 19, offset: 701},
+													val:        ".",
 													ignoreCase: false,
-													want:       "\"+\"",
+													want:       "\".\"",
 												},
-												&litMatcher{
-													pos:        position{line: 28, col: 50, offset: 465},
-													val:        "-",
-													ignoreCase: false,
-													want:       "\"-\"",
+												&ruleRefExpr{
+													pos:  position{line: 30, col: 23, offset: 705},
+													name: "digits",
 												},
 											},
 										},
+										&notExpr{
+											pos: position{line: 30, col: 32, offset: 714},
+											expr: &litMatcher{
+												pos:        position{line: 30, col: 33, offset: 715},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+										},
 									},
-									&oneOrMoreExpr{
-										pos: position{line: 28, col: 56, offset: 471},
-										expr: &ruleRefExpr{
-											pos:  position{line: 28, col: 56, offset: 471},
-											name: "digit",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 30, col: 38, offset: 720},
+									expr: &seqExpr{
+										pos: position{line: 30, col: 39, offset: 721},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 30, col: 39, offset: 721},
+												val:        "e",
+												ignoreCase: true,
+												want:       "\"e\"i",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 30, col: 44, offset: 726},
+												expr: &ruleRefExpr{
+													pos:  position{line: 30, col: 44, offset: 726},
+													name: "sign",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 30, col: 50, offset: 732},
+												name: "digits",
+											},
 										},
 									},
 								},
+								&notExpr{
+									pos: position{line: 30, col: 59, offset: 741},
+									expr: &ruleRefExpr{
+										pos:  position{line: 30, col: 60, offset: 742},
+										name: "numChr",
+									},
+								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "String",
-			pos:  position{line: 33, col: 1, offset: 550},
-			expr: &choiceExpr{
-				pos: position{line: 33, col: 11, offset: 562},
-				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 33, col: 11, offset: 562},
-						run: (*parser).callonString2,
+						pos: position{line: 32, col: 5, offset: 778},
+						run: (*parser).callonNumber37,
 						expr: &seqExpr{
-							pos: position{line: 33, col: 11, offset: 562},
+							pos: position{line: 32, col: 5, offset: 778},
 							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 32, col: 5, offset: 778},
+									expr: &ruleRefExpr{
+										pos:  position{line: 32, col: 5, offset: 778},
+										name: "sign",
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 33, col: 11, offset: 562},
-									val:        "\"",
-									ignoreCase: false,
-									want:       "\"\\\"\"",
+									pos:        position{line: 32, col: 11, offset: 784},
+									val:        "0x",
+									ignoreCase: true,
+									want:       "\"0x\"i",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 33, col: 15, offset: 566},
+									pos: position{line: 32, col: 17, offset: 790},
 									expr: &ruleRefExpr{
-										pos:  position{line: 33, col: 15, offset: 566},
-										name: "runeChr",
+										pos:  position{line: 32, col: 17, offset: 790},
+										name: "numChr",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 33, col: 24, offset: 575},
-									val:        "\"",
-									ignoreCase: false,
-									want:       "\"\\\"\"",
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 35, col: 5, offset: 634},
-						run: (*parser).callonString8,
+						pos: position{line: 34, col: 5, offset: 861},
+						run: (*parser).callonNumber44,
 						expr: &seqExpr{
-							pos: position{line: 35, col: 5, offset: 634},
+							pos: position{line: 34, col: 5, offset: 861},
 							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 34, col: 5, offset: 861},
+									expr: &ruleRefExpr{
+										pos:  position{line: 34, col: 5, offset: 861},
+										name: "sign",
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 35, col: 5, offset: 634},
-									val:        "\"",
-									ignoreCase: false,
-									want:       "\"\\\"\"",
+									pos:        position{line: 34, col: 11, offset: 867},
+									val:        "0b",
+									ignoreCase: true,
+									want:       "\"0b\"i",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 35, col: 9, offset: 638},
+									pos: position{line: 34, col: 17, offset: 873},
 									expr: &ruleRefExpr{
-										pos:  position{line: 35, col: 9, offset: 638},
-										name: "runeChr",
-									},
-								},
-								&notExpr{
-									pos: position{line: 35, col: 18, offset: 647},
-									expr: &litMatcher{
-										pos:        position{line: 35, col: 19, offset: 648},
-										val:        "\"",
-										ignoreCase: false,
-										want:       "\"\\\"\"",
+										pos:  position{line: 34, col: 17, offset: 873},
+										name: "numChr",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "runeChr",
-			pos:  position{line: 39, col: 1, offset: 797},
-			expr: &choiceExpr{
-				pos: position{line: 39, col: 12, offset: 810},
-				alternatives: []interface{}{
-					&charClassMatcher{
-						pos:        position{line: 39, col: 12, offset: 810},
-						val:        "[^\"\\\\]",
-						chars:      []rune{'"', '\\'},
-						ignoreCase: false,
-						inverted:   true,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 39, col: 21, offset: 819},
-						name: "runeEsc",
-					},
-				},
-			},
-		},
-		{
-			name: "runeEsc",
-			pos:  position{line: 40, col: 1, offset: 827},
-			expr: &seqExpr{
-				pos: position{line: 40, col: 12, offset: 840},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 40, col: 12, offset: 840},
-						val:        "\\",
-						ignoreCase: false,
-						want:       "\"\\\\\"",
-					},
-					&choiceExpr{
-						pos: position{line: 40, col: 17, offset: 845},
-						alternatives: []interface{}{
-							&charClassMatcher{
-								pos:        position{line: 40, col: 17, offset: 845},
-								val:        "[\"\\\\/abfnrtv]",
-								chars:      []rune{'"', '\\', '/', 'a', 'b', 'f', 'n', 'r', 't', 'v'},
-								ignoreCase: false,
-								inverted:   false,
-							},
-							&seqExpr{
-								pos: position{line: 41, col: 13, offset: 873},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 41, col: 13, offset: 873},
-										val:        "x",
-										ignoreCase: false,
-										want:       "\"x\"",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 41, col: 17, offset: 877},
-										name: "hexDigit",
+					&actionExpr{
+						pos: position{line: 36, col: 5, offset: 947},
+						run: (*parser).callonNumber51,
+						expr: &seqExpr{
+							pos: position{line: 36, col: 5, offset: 947},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 36, col: 5, offset: 947},
+									expr: &ruleRefExpr{
+										pos:  position{line: 36, col: 5, offset: 947},
+										name: "sign",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 41, col: 26, offset: 886},
-										name: "hexDigit",
+								},
+								&litMatcher{
+									pos:        position{line: 36, col: 11, offset: 953},
+									val:        "0o",
+									ignoreCase: true,
+									want:       "\"0o\"i",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 36, col: 17, offset: 959},
+									expr: &ruleRefExpr{
+										pos:  position{line: 36, col: 17, offset: 959},
+										name: "numChr",
 									},
 								},
 							},
-							&seqExpr{
-								pos: position{line: 42, col: 13, offset: 910},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 42, col: 13, offset: 910},
-										val:        "u",
-										ignoreCase: false,
-										want:       "\"u\"",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 42, col: 17, offset: 914},
-										name: "hexDigit",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 42, col: 26, offset: 923},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 38, col: 5, offset: 1032},
+						run: (*parser).callonNumber58,
+						expr: &seqExpr{
+							pos: position{line: 38, col: 5, offset: 1032},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 38, col: 5, offset: 1032},
+									expr: &ruleRefExpr{
+										pos:  position{line: 38, col: 5, offset: 1032},
+										name: "sign",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 38, col: 11, offset: 1038},
+									name: "digits",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 38, col: 18, offset: 1045},
+									expr: &seqExpr{
+										pos: position{line: 38, col: 19, offset: 1046},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 38, col: 19, offset: 1046},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 38, col: 23, offset: 1050},
+												name: "digits",
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 38, col: 32, offset: 1059},
+									val:        "e",
+									ignoreCase: true,
+									want:       "\"e\"i",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 38, col: 37, offset: 1064},
+									expr: &ruleRefExpr{
+										pos:  position{line: 38, col: 37, offset: 1064},
+										name: "sign",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 38, col: 43, offset: 1070},
+									expr: &ruleRefExpr{
+										pos:  position{line: 38, col: 43, offset: 1070},
+										name: "numChr",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 40, col: 5, offset: 1146},
+						run: (*parser).callonNumber72,
+						expr: &seqExpr{
+							pos: position{line: 40, col: 5, offset: 1146},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 40, col: 5, offset: 1146},
+									expr: &ruleRefExpr{
+										pos:  position{line: 40, col: 5, offset: 1146},
+										name: "sign",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 40, col: 11, offset: 1152},
+									name: "digits",
+								},
+								&litMatcher{
+									pos:        position{line: 40, col: 18, offset: 1159},
+									val:        ".",
+									ignoreCase: false,
+									want:       "\".\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 40, col: 22, offset: 1163},
+									expr: &ruleRefExpr{
+										pos:  position{line: 40, col: 22, offset: 1163},
+										name: "numChr",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 42, col: 5, offset: 1239},
+						run: (*parser).callonNumber80,
+						expr: &seqExpr{
+							pos: position{line: 42, col: 5, offset: 1239},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 42, col: 5, offset: 1239},
+									expr: &ruleRefExpr{
+										pos:  position{line: 42, col: 5, offset: 1239},
+										name: "sign",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 42, col: 11, offset: 1245},
+									name: "digit",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 42, col: 17, offset: 1251},
+									expr: &ruleRefExpr{
+										pos:  position{line: 42, col: 17, offset: 1251},
+										name: "numChr",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Rational",
+			pos:  position{line: 46, col: 1, offset: 1373},
+			expr: &actionExpr{
+				pos: position{line: 46, col: 13, offset: 1387},
+				run: (*parser).callonRational1,
+				expr: &seqExpr{
+					pos: position{line: 46, col: 13, offset: 1387},
+					exprs: []interface{}{
+						&zeroOrOneExpr{
+							pos: position{line: 46, col: 13, offset: 1387},
+							expr: &ruleRefExpr{
+								pos:  position{line: 46, col: 13, offset: 1387},
+								name: "sign",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 46, col: 19, offset: 1393},
+							name: "digits",
+						},
+						&litMatcher{
+							pos:        position{line: 46, col: 26, offset: 1400},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 46, col: 30, offset: 1404},
+							name: "digits",
+						},
+						&litMatcher{
+							pos:        position{line: 46, col: 37, offset: 1411},
+							val:        "r",
+							ignoreCase: false,
+							want:       "\"r\"",
+						},
+						&notExpr{
+							pos: position{line: 46, col: 41, offset: 1415},
+							expr: &ruleRefExpr{
+								pos:  position{line: 46, col: 42, offset: 1416},
+								name: "numChr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "sign",
+			pos:  position{line: 49, col: 1, offset: 1448},
+			expr: &charClassMatcher{
+				pos:        position{line: 49, col: 9, offset: 1458},
+				val:        "[-+]",
+				chars:      []rune{'-', '+'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "digits",
+			pos:  position{line: 51, col: 1, offset: 1507},
+			expr: &seqExpr{
+				pos: position{line: 51, col: 11, offset: 1519},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 11, offset: 1519},
+						name: "digit",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 51, col: 17, offset: 1525},
+						expr: &seqExpr{
+							pos: position{line: 51, col: 18, offset: 1526},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 51, col: 18, offset: 1526},
+									expr: &litMatcher{
+										pos:        position{line: 51, col: 18, offset: 1526},
+										val:        "_",
+										ignoreCase: false,
+										want:       "\"_\"",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 51, col: 23, offset: 1531},
+									name: "digit",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "hexDigits",
+			pos:  position{line: 52, col: 1, offset: 1539},
+			expr: &seqExpr{
+				pos: position{line: 52, col: 14, offset: 1554},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 52, col: 14, offset: 1554},
+						name: "hexDigit",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 52, col: 23, offset: 1563},
+						expr: &seqExpr{
+							pos: position{line: 52, col: 24, offset: 1564},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 52, col: 24, offset: 1564},
+									expr: &litMatcher{
+										pos:        position{line: 52, col: 24, offset: 1564},
+										val:        "_",
+										ignoreCase: false,
+										want:       "\"_\"",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 52, col: 29, offset: 1569},
+									name: "hexDigit",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "binDigits",
+			pos:  position{line: 53, col: 1, offset: 1580},
+			expr: &seqExpr{
+				pos: position{line: 53, col: 14, offset: 1595},
+				exprs: []interface{}{
+					&charClassMatcher{
+						pos:        position{line: 53, col: 14, offset: 1595},
+						val:        "[01]",
+						chars:      []rune{'0', '1'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 53, col: 19, offset: 1600},
+						expr: &seqExpr{
+							pos: position{line: 53, col: 20, offset: 1601},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 53, col: 20, offset: 1601},
+									expr: &litMatcher{
+										pos:        position{line: 53, col: 20, offset: 1601},
+										val:        "_",
+										ignoreCase: false,
+										want:       "\"_\"",
+									},
+								},
+								&charClassMatcher{
+									pos:        position{line: 53, col: 25, offset: 1606},
+									val:        "[01]",
+									chars:      []rune{'0', '1'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "octDigits",
+			pos:  position{line: 54, col: 1, offset: 1613},
+			expr: &seqExpr{
+				pos: position{line: 54, col: 14, offset: 1628},
+				exprs: []interface{}{
+					&charClassMatcher{
+						pos:        position{line: 54, col: 14, offset: 1628},
+						val:        "[0-7]",
+						ranges:     []rune{'0', '7'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 54, col: 20, offset: 1634},
+						expr: &seqExpr{
+							pos: position{line: 54, col: 21, offset: 1635},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 54, col: 21, offset: 1635},
+									expr: &litMatcher{
+										pos:        position{line: 54, col: 21, offset: 1635},
+										val:        "_",
+										ignoreCase: false,
+										want:       "\"_\"",
+									},
+								},
+								&charClassMatcher{
+									pos:        position{line: 54, col: 26, offset: 1640},
+									val:        "[0-7]",
+									ranges:     []rune{'0', '7'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "numChr",
+			pos:  position{line: 56, col: 1, offset: 1680},
+			expr: &choiceExpr{
+				pos: position{line: 56, col: 11, offset: 1692},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 56, col: 11, offset: 1692},
+						name: "letter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 56, col: 20, offset: 1701},
+						name: "digit",
+					},
+				},
+			},
+		},
+		{
+			name: "String",
+			pos:  position{line: 59, col: 1, offset: 1753},
+			expr: &choiceExpr{
+				pos: position{line: 59, col: 11, offset: 1765},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 59, col: 11, offset: 1765},
+						run: (*parser).callonString2,
+						expr: &seqExpr{
+							pos: position{line: 59, col: 11, offset: 1765},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 59, col: 11, offset: 1765},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 59, col: 15, offset: 1769},
+									label: "parts",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 59, col: 21, offset: 1775},
+										expr: &ruleRefExpr{
+											pos:  position{line: 59, col: 21, offset: 1775},
+											name: "strPart",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 59, col: 30, offset: 1784},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 61, col: 5, offset: 1832},
+						run: (*parser).callonString9,
+						expr: &seqExpr{
+							pos: position{line: 61, col: 5, offset: 1832},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 61, col: 5, offset: 1832},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 61, col: 9, offset: 1836},
+									expr: &ruleRefExpr{
+										pos:  position{line: 61, col: 9, offset: 1836},
+										name: "strPart",
+									},
+								},
+								&notExpr{
+									pos: position{line: 61, col: 18, offset: 1845},
+									expr: &litMatcher{
+										pos:        position{line: 61, col: 19, offset: 1846},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "strPart",
+			pos:  position{line: 64, col: 1, offset: 1907},
+			expr: &choiceExpr{
+				pos: position{line: 64, col: 12, offset: 1920},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 64, col: 12, offset: 1920},
+						run: (*parser).callonstrPart2,
+						expr: &seqExpr{
+							pos: position{line: 64, col: 12, offset: 1920},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 64, col: 12, offset: 1920},
+									val:        "${",
+									ignoreCase: false,
+									want:       "\"${\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 64, col: 17, offset: 1925},
+									expr: &ruleRefExpr{
+										pos:  position{line: 64, col: 17, offset: 1925},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 64, col: 20, offset: 1928},
+									label: "exp",
+									expr: &ruleRefExpr{
+										pos:  position{line: 64, col: 24, offset: 1932},
+										name: "Expr",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 64, col: 29, offset: 1937},
+									expr: &ruleRefExpr{
+										pos:  position{line: 64, col: 29, offset: 1937},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 64, col: 32, offset: 1940},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 66, col: 5, offset: 1968},
+						run: (*parser).callonstrPart12,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 66, col: 5, offset: 1968},
+							expr: &seqExpr{
+								pos: position{line: 66, col: 6, offset: 1969},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 66, col: 6, offset: 1969},
+										expr: &litMatcher{
+											pos:        position{line: 66, col: 7, offset: 1970},
+											val:        "${",
+											ignoreCase: false,
+											want:       "\"${\"",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 66, col: 12, offset: 1975},
+										name: "runeChr",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Key",
+			pos:  position{line: 70, col: 1, offset: 2103},
+			expr: &actionExpr{
+				pos: position{line: 70, col: 8, offset: 2112},
+				run: (*parser).callonKey1,
+				expr: &seqExpr{
+					pos: position{line: 70, col: 8, offset: 2112},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 70, col: 8, offset: 2112},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 70, col: 12, offset: 2116},
+							expr: &ruleRefExpr{
+								pos:  position{line: 70, col: 12, offset: 2116},
+								name: "runeChr",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 70, col: 21, offset: 2125},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "runeChr",
+			pos:  position{line: 75, col: 1, offset: 2310},
+			expr: &choiceExpr{
+				pos: position{line: 75, col: 12, offset: 2323},
+				alternatives: []interface{}{
+					&charClassMatcher{
+						pos:        position{line: 75, col: 12, offset: 2323},
+						val:        "[^\"\\\\]",
+						chars:      []rune{'"', '\\'},
+						ignoreCase: false,
+						inverted:   true,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 75, col: 21, offset: 2332},
+						name: "runeEsc",
+					},
+				},
+			},
+		},
+		{
+			name: "runeEsc",
+			pos:  position{line: 76, col: 1, offset: 2340},
+			expr: &seqExpr{
+				pos: position{line: 76, col: 12, offset: 2353},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 76, col: 12, offset: 2353},
+						val:        "\\",
+						ignoreCase: false,
+						want:       "\"\\\\\"",
+					},
+					&choiceExpr{
+						pos: position{line: 76, col: 17, offset: 2358},
+						alternatives: []interface{}{
+							&charClassMatcher{
+								pos:        position{line: 76, col: 17, offset: 2358},
+								val:        "[\"\\\\/$abfnrtv]",
+								chars:      []rune{'"', '\\', '/', '$', 'a', 'b', 'f', 'n', 'r', 't', 'v'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+							&seqExpr{
+								pos: position{line: 77, col: 13, offset: 2387},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 77, col: 13, offset: 2387},
+										val:        "x",
+										ignoreCase: false,
+										want:       "\"x\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 77, col: 17, offset: 2391},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 77, col: 26, offset: 2400},
+										name: "hexDigit",
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 78, col: 13, offset: 2424},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 78, col: 13, offset: 2424},
+										val:        "u",
+										ignoreCase: false,
+										want:       "\"u\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 78, col: 17, offset: 2428},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 78, col: 26, offset: 2437},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 78, col: 35, offset: 2446},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 78, col: 44, offset: 2455},
+										name: "hexDigit",
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 79, col: 13, offset: 2479},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 79, col: 13, offset: 2479},
+										val:        "U",
+										ignoreCase: false,
+										want:       "\"U\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 17, offset: 2483},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 26, offset: 2492},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 35, offset: 2501},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 44, offset: 2510},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 53, offset: 2519},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 62, offset: 2528},
+										name: "hexDigit",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 71, offset: 2537},
 										name: "hexDigit",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 42, col: 35, offset: 932},
-										name: "hexDigit",
+									&ruleRefExpr{
+										pos:  position{line: 79, col: 80, offset: 2546},
+										name: "hexDigit",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "hexDigit",
+			pos:  position{line: 80, col: 1, offset: 2557},
+			expr: &charClassMatcher{
+				pos:        position{line: 80, col: 12, offset: 2570},
+				val:        "[0-9a-f]i",
+				ranges:     []rune{'0', '9', 'a', 'f'},
+				ignoreCase: true,
+				inverted:   false,
+			},
+		},
+		{
+			name: "Regex",
+			pos:  position{line: 83, col: 1, offset: 2641},
+			expr: &choiceExpr{
+				pos: position{line: 83, col: 10, offset: 2652},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 83, col: 10, offset: 2652},
+						run: (*parser).callonRegex2,
+						expr: &seqExpr{
+							pos: position{line: 83, col: 10, offset: 2652},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 83, col: 10, offset: 2652},
+									val:        "#\"",
+									ignoreCase: false,
+									want:       "\"#\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 83, col: 16, offset: 2658},
+									expr: &choiceExpr{
+										pos: position{line: 83, col: 17, offset: 2659},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 83, col: 17, offset: 2659},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 83, col: 17, offset: 2659},
+														val:        "\\",
+														ignoreCase: false,
+														want:       "\"\\\\\"",
+													},
+													&anyMatcher{
+														line: 83, col: 21, offset: 2663,
+													},
+												},
+											},
+											&charClassMatcher{
+												pos:        position{line: 83, col: 25, offset: 2667},
+												val:        "[^\"\\\\]",
+												chars:      []rune{'"', '\\'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 83, col: 34, offset: 2676},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 85, col: 5, offset: 2740},
+						run: (*parser).callonRegex12,
+						expr: &seqExpr{
+							pos: position{line: 85, col: 5, offset: 2740},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 85, col: 5, offset: 2740},
+									val:        "#\"",
+									ignoreCase: false,
+									want:       "\"#\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 85, col: 11, offset: 2746},
+									expr: &choiceExpr{
+										pos: position{line: 85, col: 12, offset: 2747},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 85, col: 12, offset: 2747},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 85, col: 12, offset: 2747},
+														val:        "\\",
+														ignoreCase: false,
+														want:       "\"\\\\\"",
+													},
+													&anyMatcher{
+														line: 85, col: 16, offset: 2751,
+													},
+												},
+											},
+											&charClassMatcher{
+												pos:        position{line: 85, col: 20, offset: 2755},
+												val:        "[^\"\\\\]",
+												chars:      []rune{'"', '\\'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 85, col: 29, offset: 2764},
+									expr: &litMatcher{
+										pos:        position{line: 85, col: 30, offset: 2765},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Array",
+			pos:  position{line: 90, col: 1, offset: 2836},
+			expr: &choiceExpr{
+				pos: position{line: 90, col: 10, offset: 2847},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 90, col: 10, offset: 2847},
+						run: (*parser).callonArray2,
+						expr: &seqExpr{
+							pos: position{line: 90, col: 10, offset: 2847},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 90, col: 10, offset: 2847},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 90, col: 14, offset: 2851},
+									label: "list",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 90, col: 19, offset: 2856},
+										expr: &ruleRefExpr{
+											pos:  position{line: 90, col: 19, offset: 2856},
+											name: "Mat",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 90, col: 24, offset: 2861},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 95, col: 5, offset: 2966},
+						run: (*parser).callonArray9,
+						expr: &seqExpr{
+							pos: position{line: 95, col: 5, offset: 2966},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 95, col: 5, offset: 2966},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 95, col: 9, offset: 2970},
+									expr: &ruleRefExpr{
+										pos:  position{line: 95, col: 9, offset: 2970},
+										name: "Mat",
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 95, col: 14, offset: 2975},
+									expr: &seqExpr{
+										pos: position{line: 95, col: 15, offset: 2976},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 95, col: 15, offset: 2976},
+												expr: &ruleRefExpr{
+													pos:  position{line: 95, col: 15, offset: 2976},
+													name: "_",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 95, col: 18, offset: 2979},
+												name: "Junk",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 95, col: 23, offset: 2984},
+												expr: &ruleRefExpr{
+													pos:  position{line: 95, col: 23, offset: 2984},
+													name: "_",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 95, col: 26, offset: 2987},
+												expr: &ruleRefExpr{
+													pos:  position{line: 95, col: 26, offset: 2987},
+													name: "Mat",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 95, col: 33, offset: 2994},
+									expr: &ruleRefExpr{
+										pos:  position{line: 95, col: 33, offset: 2994},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 95, col: 36, offset: 2997},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 97, col: 5, offset: 3041},
+						run: (*parser).callonArray26,
+						expr: &seqExpr{
+							pos: position{line: 97, col: 5, offset: 3041},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 97, col: 5, offset: 3041},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 97, col: 9, offset: 3045},
+									expr: &ruleRefExpr{
+										pos:  position{line: 97, col: 9, offset: 3045},
+										name: "Mat",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 97, col: 14, offset: 3050},
+									expr: &seqExpr{
+										pos: position{line: 97, col: 15, offset: 3051},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 97, col: 15, offset: 3051},
+												expr: &ruleRefExpr{
+													pos:  position{line: 97, col: 15, offset: 3051},
+													name: "_",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 97, col: 18, offset: 3054},
+												name: "Junk",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 97, col: 23, offset: 3059},
+												expr: &ruleRefExpr{
+													pos:  position{line: 97, col: 23, offset: 3059},
+													name: "_",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 97, col: 26, offset: 3062},
+												expr: &ruleRefExpr{
+													pos:  position{line: 97, col: 26, offset: 3062},
+													name: "Mat",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 97, col: 33, offset: 3069},
+									expr: &ruleRefExpr{
+										pos:  position{line: 97, col: 33, offset: 3069},
+										name: "_",
+									},
+								},
+								&notExpr{
+									pos: position{line: 97, col: 36, offset: 3072},
+									expr: &litMatcher{
+										pos:        position{line: 97, col: 37, offset: 3073},
+										val:        "]",
+										ignoreCase: false,
+										want:       "\"]\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Mat",
+			pos:  position{line: 100, col: 1, offset: 3133},
+			expr: &actionExpr{
+				pos: position{line: 100, col: 8, offset: 3142},
+				run: (*parser).callonMat1,
+				expr: &seqExpr{
+					pos: position{line: 100, col: 8, offset: 3142},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 100, col: 8, offset: 3142},
+							label: "first",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 100, col: 14, offset: 3148},
+								expr: &ruleRefExpr{
+									pos:  position{line: 100, col: 14, offset: 3148},
+									name: "List",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 100, col: 20, offset: 3154},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 100, col: 25, offset: 3159},
+								expr: &seqExpr{
+									pos: position{line: 100, col: 26, offset: 3160},
+									exprs: []interface{}{
+										&litMatcher{
+											pos:        position{line: 100, col: 26, offset: 3160},
+											val:        ";",
+											ignoreCase: false,
+											want:       "\";\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 100, col: 30, offset: 3164},
+											expr: &ruleRefExpr{
+												pos:  position{line: 100, col: 30, offset: 3164},
+												name: "List",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "List",
+			pos:  position{line: 111, col: 1, offset: 3361},
+			expr: &actionExpr{
+				pos: position{line: 111, col: 9, offset: 3371},
+				run: (*parser).callonList1,
+				expr: &labeledExpr{
+					pos:   position{line: 111, col: 9, offset: 3371},
+					label: "list",
+					expr: &choiceExpr{
+						pos: position{line: 111, col: 15, offset: 3377},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 111, col: 15, offset: 3377},
+								name: "Seq",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 111, col: 21, offset: 3383},
+								name: "Lone",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 111, col: 28, offset: 3390},
+								name: "Unary",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Call",
+			pos:  position{line: 115, col: 1, offset: 3463},
+			expr: &actionExpr{
+				pos: position{line: 115, col: 9, offset: 3473},
+				run: (*parser).callonCall1,
+				expr: &seqExpr{
+					pos: position{line: 115, col: 9, offset: 3473},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 115, col: 9, offset: 3473},
+							label: "seq",
+							expr: &ruleRefExpr{
+								pos:  position{line: 115, col: 13, offset: 3477},
+								name: "Seq",
+							},
+						},
+						&notExpr{
+							pos: position{line: 115, col: 17, offset: 3481},
+							expr: &ruleRefExpr{
+								pos:  position{line: 115, col: 18, offset: 3482},
+								name: "PipeOp",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Seq",
+			pos:  position{line: 119, col: 1, offset: 3601},
+			expr: &actionExpr{
+				pos: position{line: 119, col: 8, offset: 3610},
+				run: (*parser).callonSeq1,
+				expr: &seqExpr{
+					pos: position{line: 119, col: 8, offset: 3610},
+					exprs: []interface{}{
+						&zeroOrMoreExpr{
+							pos: position{line: 119, col: 8, offset: 3610},
+							expr: &ruleRefExpr{
+								pos:  position{line: 119, col: 8, offset: 3610},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 119, col: 11, offset: 3613},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 119, col: 17, offset: 3619},
+								name: "Item",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 119, col: 22, offset: 3624},
+							label: "rest",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 119, col: 27, offset: 3629},
+								expr: &seqExpr{
+									pos: position{line: 119, col: 28, offset: 3630},
+									exprs: []interface{}{
+										&oneOrMoreExpr{
+											pos: position{line: 119, col: 28, offset: 3630},
+											expr: &ruleRefExpr{
+												pos:  position{line: 119, col: 28, offset: 3630},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 119, col: 31, offset: 3633},
+											name: "Item",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 119, col: 38, offset: 3640},
+							expr: &ruleRefExpr{
+								pos:  position{line: 119, col: 38, offset: 3640},
+								name: "_",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Map",
+			pos:  position{line: 124, col: 1, offset: 3690},
+			expr: &choiceExpr{
+				pos: position{line: 124, col: 8, offset: 3699},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 124, col: 8, offset: 3699},
+						run: (*parser).callonMap2,
+						expr: &seqExpr{
+							pos: position{line: 124, col: 8, offset: 3699},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 124, col: 8, offset: 3699},
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 124, col: 12, offset: 3703},
+									expr: &ruleRefExpr{
+										pos:  position{line: 124, col: 12, offset: 3703},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 124, col: 15, offset: 3706},
+									label: "first",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 124, col: 21, offset: 3712},
+										expr: &seqExpr{
+											pos: position{line: 124, col: 22, offset: 3713},
+											exprs: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 124, col: 22, offset: 3713},
+													name: "Key",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 124, col: 26, offset: 3717},
+													expr: &ruleRefExpr{
+														pos:  position{line: 124, col: 26, offset: 3717},
+														name: "_",
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 124, col: 29, offset: 3720},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 124, col: 33, offset: 3724},
+													expr: &ruleRefExpr{
+														pos:  position{line: 124, col: 33, offset: 3724},
+														name: "_",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 124, col: 36, offset: 3727},
+													name: "Item",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 124, col: 43, offset: 3734},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 124, col: 48, offset: 3739},
+										expr: &seqExpr{
+											pos: position{line: 124, col: 49, offset: 3740},
+											exprs: []interface{}{
+												&oneOrMoreExpr{
+													pos: position{line: 124, col: 49, offset: 3740},
+													expr: &ruleRefExpr{
+														pos:  position{line: 124, col: 49, offset: 3740},
+														name: "_",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 124, col: 52, offset: 3743},
+													name: "Key",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 124, col: 56, offset: 3747},
+													expr: &ruleRefExpr{
+														pos:  position{line: 124, col: 56, offset: 3747},
+														name: "_",
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 124, col: 59, offset: 3750},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 124, col: 63, offset: 3754},
+													expr: &ruleRefExpr{
+														pos:  position{line: 124, col: 63, offset: 3754},
+														name: "_",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 124, col: 66, offset: 3757},
+													name: "Item",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 124, col: 73, offset: 3764},
+									expr: &ruleRefExpr{
+										pos:  position{line: 124, col: 73, offset: 3764},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 124, col: 76, offset: 3767},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 126, col: 5, offset: 3819},
+						run: (*parser).callonMap32,
+						expr: &seqExpr{
+							pos: position{line: 126, col: 5, offset: 3819},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 126, col: 5, offset: 3819},
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 126, col: 9, offset: 3823},
+									expr: &ruleRefExpr{
+										pos:  position{line: 126, col: 9, offset: 3823},
+										name: "_",
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 126, col: 12, offset: 3826},
+									expr: &seqExpr{
+										pos: position{line: 126, col: 13, offset: 3827},
+										exprs: []interface{}{
+											&choiceExpr{
+												pos: position{line: 126, col: 14, offset: 3828},
+												alternatives: []interface{}{
+													&seqExpr{
+														pos: position{line: 126, col: 15, offset: 3829},
+														exprs: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 126, col: 15, offset: 3829},
+																name: "Key",
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 126, col: 19, offset: 3833},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 126, col: 19, offset: 3833},
+																	name: "_",
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 126, col: 22, offset: 3836},
+																val:        ":",
+																ignoreCase: false,
+																want:       "\":\"",
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 126, col: 26, offset: 3840},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 126, col: 26, offset: 3840},
+																	name: "_",
+																},
+															},
+															&ruleRefExpr{
+																pos:  position{line: 126, col: 29, offset: 3843},
+																name: "Item",
+															},
+														},
+													},
+													&ruleRefExpr{
+														pos:  position{line: 126, col: 37, offset: 3851},
+														name: "Junk",
+													},
+												},
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 126, col: 43, offset: 3857},
+												expr: &ruleRefExpr{
+													pos:  position{line: 126, col: 43, offset: 3857},
+													name: "_",
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 126, col: 48, offset: 3862},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 128, col: 5, offset: 3906},
+						run: (*parser).callonMap52,
+						expr: &seqExpr{
+							pos: position{line: 128, col: 5, offset: 3906},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 128, col: 5, offset: 3906},
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 128, col: 9, offset: 3910},
+									expr: &ruleRefExpr{
+										pos:  position{line: 128, col: 9, offset: 3910},
+										name: "_",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 128, col: 12, offset: 3913},
+									expr: &seqExpr{
+										pos: position{line: 128, col: 13, offset: 3914},
+										exprs: []interface{}{
+											&choiceExpr{
+												pos: position{line: 128, col: 14, offset: 3915},
+												alternatives: []interface{}{
+													&seqExpr{
+														pos: position{line: 128, col: 15, offset: 3916},
+														exprs: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 128, col: 15, offset: 3916},
+																name: "Key",
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 128, col: 19, offset: 3920},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 128, col: 19, offset: 3920},
+																	name: "_",
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 128, col: 22, offset: 3923},
+																val:        ":",
+																ignoreCase: false,
+																want:       "\":\"",
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 128, col: 26, offset: 3927},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 128, col: 26, offset: 3927},
+																	name: "_",
+																},
+															},
+															&ruleRefExpr{
+																pos:  position{line: 128, col: 29, offset: 3930},
+																name: "Item",
+															},
+														},
+													},
+													&ruleRefExpr{
+														pos:  position{line: 128, col: 37, offset: 3938},
+														name: "Junk",
+													},
+												},
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 128, col: 43, offset: 3944},
+												expr: &ruleRefExpr{
+													pos:  position{line: 128, col: 43, offset: 3944},
+													name: "_",
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 128, col: 48, offset: 3949},
+									expr: &litMatcher{
+										pos:        position{line: 128, col: 49, offset: 3950},
+										val:        "}",
+										ignoreCase: false,
+										want:       "\"}\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Item",
+			pos:  position{line: 133, col: 1, offset: 4082},
+			expr: &choiceExpr{
+				pos: position{line: 133, col: 9, offset: 4092},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 133, col: 9, offset: 4092},
+						name: "Name",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 133, col: 16, offset: 4099},
+						name: "Postfix",
+					},
+				},
+			},
+		},
+		{
+			name: "Postfix",
+			pos:  position{line: 136, col: 1, offset: 4193},
+			expr: &actionExpr{
+				pos: position{line: 136, col: 12, offset: 4206},
+				run: (*parser).callonPostfix1,
+				expr: &seqExpr{
+					pos: position{line: 136, col: 12, offset: 4206},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 136, col: 12, offset: 4206},
+							label: "any",
+							expr: &ruleRefExpr{
+								pos:  position{line: 136, col: 16, offset: 4210},
+								name: "Any",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 136, col: 20, offset: 4214},
+							label: "suffixes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 136, col: 29, offset: 4223},
+								expr: &choiceExpr{
+									pos: position{line: 136, col: 30, offset: 4224},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 136, col: 30, offset: 4224},
+											name: "Member",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 136, col: 39, offset: 4233},
+											name: "Slice",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 136, col: 47, offset: 4241},
+											name: "Index",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Member",
+			pos:  position{line: 139, col: 1, offset: 4304},
+			expr: &actionExpr{
+				pos: position{line: 139, col: 11, offset: 4316},
+				run: (*parser).callonMember1,
+				expr: &seqExpr{
+					pos: position{line: 139, col: 11, offset: 4316},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 139, col: 11, offset: 4316},
+							val:        ".",
+							ignoreCase: false,
+							want:       "\".\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 139, col: 15, offset: 4320},
+							name: "word",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 139, col: 20, offset: 4325},
+							expr: &choiceExpr{
+								pos: position{line: 139, col: 21, offset: 4326},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 139, col: 21, offset: 4326},
+										val:        "!",
+										ignoreCase: false,
+										want:       "\"!\"",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 42, col: 44, offset: 941},
-										name: "hexDigit",
+									&litMatcher{
+										pos:        position{line: 139, col: 27, offset: 4332},
+										val:        "?",
+										ignoreCase: false,
+										want:       "\"?\"",
 									},
 								},
 							},
-							&seqExpr{
-								pos: position{line: 43, col: 13, offset: 965},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 43, col: 13, offset: 965},
-										val:        "U",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Index",
+			pos:  position{line: 143, col: 1, offset: 4440},
+			expr: &actionExpr{
+				pos: position{line: 143, col: 10, offset: 4451},
+				run: (*parser).callonIndex1,
+				expr: &seqExpr{
+					pos: position{line: 143, col: 10, offset: 4451},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 143, col: 10, offset: 4451},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 143, col: 14, offset: 4455},
+							expr: &ruleRefExpr{
+								pos:  position{line: 143, col: 14, offset: 4455},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 143, col: 17, offset: 4458},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 143, col: 21, offset: 4462},
+								name: "Expr",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 143, col: 26, offset: 4467},
+							expr: &ruleRefExpr{
+								pos:  position{line: 143, col: 26, offset: 4467},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 143, col: 29, offset: 4470},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Slice",
+			pos:  position{line: 146, col: 1, offset: 4538},
+			expr: &actionExpr{
+				pos: position{line: 146, col: 10, offset: 4549},
+				run: (*parser).callonSlice1,
+				expr: &seqExpr{
+					pos: position{line: 146, col: 10, offset: 4549},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 146, col: 10, offset: 4549},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 146, col: 14, offset: 4553},
+							expr: &ruleRefExpr{
+								pos:  position{line: 146, col: 14, offset: 4553},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 146, col: 17, offset: 4556},
+							label: "start",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 146, col: 23, offset: 4562},
+								expr: &ruleRefExpr{
+									pos:  position{line: 146, col: 23, offset: 4562},
+									name: "Expr",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 146, col: 29, offset: 4568},
+							expr: &ruleRefExpr{
+								pos:  position{line: 146, col: 29, offset: 4568},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 146, col: 32, offset: 4571},
+							val:        ":",
+							ignoreCase: false,
+							want:       "\":\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 146, col: 36, offset: 4575},
+							expr: &ruleRefExpr{
+								pos:  position{line: 146, col: 36, offset: 4575},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 146, col: 39, offset: 4578},
+							label: "end",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 146, col: 43, offset: 4582},
+								expr: &ruleRefExpr{
+									pos:  position{line: 146, col: 43, offset: 4582},
+									name: "Expr",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 146, col: 49, offset: 4588},
+							expr: &ruleRefExpr{
+								pos:  position{line: 146, col: 49, offset: 4588},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 146, col: 52, offset: 4591},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Symbol",
+			pos:  position{line: 151, col: 1, offset: 4713},
+			expr: &choiceExpr{
+				pos: position{line: 151, col: 11, offset: 4725},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 151, col: 11, offset: 4725},
+						run: (*parser).callonSymbol2,
+						expr: &seqExpr{
+							pos: position{line: 151, col: 11, offset: 4725},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 151, col: 11, offset: 4725},
+									expr: &choiceExpr{
+										pos: position{line: 151, col: 13, offset: 4727},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 151, col: 13, offset: 4727},
+												name: "Null",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 151, col: 20, offset: 4734},
+												name: "Boolean",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 151, col: 29, offset: 4743},
+									name: "word",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 151, col: 34, offset: 4748},
+									expr: &choiceExpr{
+										pos: position{line: 151, col: 35, offset: 4749},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 151, col: 35, offset: 4749},
+												val:        "!",
+												ignoreCase: false,
+												want:       "\"!\"",
+											},
+											&litMatcher{
+												pos:        position{line: 151, col: 41, offset: 4755},
+												val:        "?",
+												ignoreCase: false,
+												want:       "\"?\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 153, col: 5, offset: 4786},
+						run: (*parser).callonSymbol13,
+						expr: &seqExpr{
+							pos: position{line: 153, col: 5, offset: 4786},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 153, col: 5, offset: 4786},
+									val:        "&",
+									ignoreCase: false,
+									want:       "\"&\"",
+								},
+								&notExpr{
+									pos: position{line: 153, col: 9, offset: 4790},
+									expr: &litMatcher{
+										pos:        position{line: 153, col: 10, offset: 4791},
+										val:        "&",
 										ignoreCase: false,
-										want:       "\"U\"",
+										want:       "\"&\"",
 									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Name",
+			pos:  position{line: 158, col: 1, offset: 4905},
+			expr: &actionExpr{
+				pos: position{line: 158, col: 9, offset: 4915},
+				run: (*parser).callonName1,
+				expr: &seqExpr{
+					pos: position{line: 158, col: 9, offset: 4915},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 158, col: 9, offset: 4915},
+							expr: &choiceExpr{
+								pos: position{line: 158, col: 11, offset: 4917},
+								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 17, offset: 969},
-										name: "hexDigit",
+										pos:  position{line: 158, col: 11, offset: 4917},
+										name: "Null",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 26, offset: 978},
-										name: "hexDigit",
+										pos:  position{line: 158, col: 18, offset: 4924},
+										name: "Boolean",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 43, col: 35, offset: 987},
-										name: "hexDigit",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 158, col: 27, offset: 4933},
+							name: "word",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 158, col: 32, offset: 4938},
+							expr: &seqExpr{
+								pos: position{line: 158, col: 33, offset: 4939},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 158, col: 33, offset: 4939},
+										val:        "-",
+										ignoreCase: false,
+										want:       "\"-\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 44, offset: 996},
-										name: "hexDigit",
+										pos:  position{line: 158, col: 37, offset: 4943},
+										name: "word",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 43, col: 53, offset: 1005},
-										name: "hexDigit",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 158, col: 44, offset: 4950},
+							expr: &choiceExpr{
+								pos: position{line: 158, col: 45, offset: 4951},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 158, col: 45, offset: 4951},
+										val:        "!",
+										ignoreCase: false,
+										want:       "\"!\"",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 43, col: 62, offset: 1014},
-										name: "hexDigit",
+									&litMatcher{
+										pos:        position{line: 158, col: 51, offset: 4957},
+										val:        "?",
+										ignoreCase: false,
+										want:       "\"?\"",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 43, col: 71, offset: 1023},
-										name: "hexDigit",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Lone",
+			pos:  position{line: 163, col: 1, offset: 5061},
+			expr: &actionExpr{
+				pos: position{line: 163, col: 9, offset: 5071},
+				run: (*parser).callonLone1,
+				expr: &seqExpr{
+					pos: position{line: 163, col: 9, offset: 5071},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 163, col: 9, offset: 5071},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 163, col: 14, offset: 5076},
+								name: "Name",
+							},
+						},
+						&andExpr{
+							pos: position{line: 163, col: 19, offset: 5081},
+							expr: &seqExpr{
+								pos: position{line: 163, col: 21, offset: 5083},
+								exprs: []interface{}{
+									&zeroOrMoreExpr{
+										pos: position{line: 163, col: 21, offset: 5083},
+										expr: &ruleRefExpr{
+											pos:  position{line: 163, col: 21, offset: 5083},
+											name: "_",
+										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 43, col: 80, offset: 1032},
-										name: "hexDigit",
+									&choiceExpr{
+										pos: position{line: 163, col: 25, offset: 5087},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 163, col: 25, offset: 5087},
+												name: "PipeOp",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 163, col: 34, offset: 5096},
+												name: "Closer",
+											},
+											&litMatcher{
+												pos:        position{line: 163, col: 43, offset: 5105},
+												val:        ";",
+												ignoreCase: false,
+												want:       "\";\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 163, col: 49, offset: 5111},
+												name: "EOF",
+											},
+										},
 									},
 								},
 							},
@@ -430,127 +2356,100 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "hexDigit",
-			pos:  position{line: 44, col: 1, offset: 1043},
-			expr: &charClassMatcher{
-				pos:        position{line: 44, col: 12, offset: 1056},
-				val:        "[0-9a-f]i",
-				ranges:     []rune{'0', '9', 'a', 'f'},
-				ignoreCase: true,
-				inverted:   false,
-			},
-		},
-		{
-			name: "Array",
-			pos:  position{line: 47, col: 1, offset: 1076},
+			name: "Quoted",
+			pos:  position{line: 168, col: 1, offset: 5192},
 			expr: &choiceExpr{
-				pos: position{line: 47, col: 10, offset: 1087},
+				pos: position{line: 168, col: 11, offset: 5204},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 47, col: 10, offset: 1087},
-						run: (*parser).callonArray2,
+						pos: position{line: 168, col: 11, offset: 5204},
+						run: (*parser).callonQuoted2,
 						expr: &seqExpr{
-							pos: position{line: 47, col: 10, offset: 1087},
+							pos: position{line: 168, col: 11, offset: 5204},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 47, col: 10, offset: 1087},
-									val:        "[",
+									pos:        position{line: 168, col: 11, offset: 5204},
+									val:        "'",
 									ignoreCase: false,
-									want:       "\"[\"",
+									want:       "\"'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 47, col: 14, offset: 1091},
-									label: "list",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 47, col: 19, offset: 1096},
-										expr: &ruleRefExpr{
-											pos:  position{line: 47, col: 19, offset: 1096},
-											name: "Mat",
+									pos:   position{line: 168, col: 15, offset: 5208},
+									label: "any",
+									expr: &choiceExpr{
+										pos: position{line: 168, col: 20, offset: 5213},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 168, col: 20, offset: 5213},
+												name: "Name",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 168, col: 27, offset: 5220},
+												name: "Any",
+											},
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 47, col: 24, offset: 1101},
-									val:        "]",
-									ignoreCase: false,
-									want:       "\"]\"",
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 52, col: 5, offset: 1181},
-						run: (*parser).callonArray9,
+						pos: position{line: 170, col: 5, offset: 5263},
+						run: (*parser).callonQuoted9,
 						expr: &seqExpr{
-							pos: position{line: 52, col: 5, offset: 1181},
+							pos: position{line: 170, col: 5, offset: 5263},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 52, col: 5, offset: 1181},
-									val:        "[",
+									pos:        position{line: 170, col: 5, offset: 5263},
+									val:        "`",
 									ignoreCase: false,
-									want:       "\"[\"",
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 52, col: 9, offset: 1185},
-									expr: &ruleRefExpr{
-										pos:  position{line: 52, col: 9, offset: 1185},
-										name: "Mat",
-									},
+									want:       "\"`\"",
 								},
-								&notExpr{
-									pos: position{line: 52, col: 14, offset: 1190},
-									expr: &litMatcher{
-										pos:        position{line: 52, col: 15, offset: 1191},
-										val:        "]",
-										ignoreCase: false,
-										want:       "\"]\"",
+								&labeledExpr{
+									pos:   position{line: 170, col: 9, offset: 5267},
+									label: "any",
+									expr: &choiceExpr{
+										pos: position{line: 170, col: 14, offset: 5272},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 170, col: 14, offset: 5272},
+												name: "Name",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 170, col: 21, offset: 5279},
+												name: "Any",
+											},
+										},
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "Mat",
-			pos:  position{line: 55, col: 1, offset: 1249},
-			expr: &actionExpr{
-				pos: position{line: 55, col: 8, offset: 1258},
-				run: (*parser).callonMat1,
-				expr: &seqExpr{
-					pos: position{line: 55, col: 8, offset: 1258},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 55, col: 8, offset: 1258},
-							label: "first",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 55, col: 14, offset: 1264},
-								expr: &ruleRefExpr{
-									pos:  position{line: 55, col: 14, offset: 1264},
-									name: "List",
+					&actionExpr{
+						pos: position{line: 172, col: 5, offset: 5327},
+						run: (*parser).callonQuoted16,
+						expr: &seqExpr{
+							pos: position{line: 172, col: 5, offset: 5327},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 172, col: 5, offset: 5327},
+									val:        "~@",
+									ignoreCase: false,
+									want:       "\"~@\"",
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 55, col: 20, offset: 1270},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 55, col: 25, offset: 1275},
-								expr: &seqExpr{
-									pos: position{line: 55, col: 26, offset: 1276},
-									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 55, col: 26, offset: 1276},
-											val:        ";",
-											ignoreCase: false,
-											want:       "\";\"",
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 55, col: 30, offset: 1280},
-											expr: &ruleRefExpr{
-												pos:  position{line: 55, col: 30, offset: 1280},
-												name: "List",
+								&labeledExpr{
+									pos:   position{line: 172, col: 10, offset: 5332},
+									label: "any",
+									expr: &choiceExpr{
+										pos: position{line: 172, col: 15, offset: 5337},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 172, col: 15, offset: 5337},
+												name: "Name",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 172, col: 22, offset: 5344},
+												name: "Any",
 											},
 										},
 									},
@@ -558,329 +2457,213 @@ var g = &grammar{
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "List",
-			pos:  position{line: 66, col: 1, offset: 1503},
-			expr: &actionExpr{
-				pos: position{line: 66, col: 9, offset: 1513},
-				run: (*parser).callonList1,
-				expr: &labeledExpr{
-					pos:   position{line: 66, col: 9, offset: 1513},
-					label: "list",
-					expr: &choiceExpr{
-						pos: position{line: 66, col: 15, offset: 1519},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 66, col: 15, offset: 1519},
-								name: "Seq",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 66, col: 21, offset: 1525},
-								name: "Unary",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Seq",
-			pos:  position{line: 70, col: 1, offset: 1609},
-			expr: &actionExpr{
-				pos: position{line: 70, col: 8, offset: 1618},
-				run: (*parser).callonSeq1,
-				expr: &seqExpr{
-					pos: position{line: 70, col: 8, offset: 1618},
-					exprs: []interface{}{
-						&zeroOrMoreExpr{
-							pos: position{line: 70, col: 8, offset: 1618},
-							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 8, offset: 1618},
-								name: "_",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 70, col: 11, offset: 1621},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 17, offset: 1627},
-								name: "Any",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 70, col: 21, offset: 1631},
-							label: "rest",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 70, col: 26, offset: 1636},
-								expr: &seqExpr{
-									pos: position{line: 70, col: 27, offset: 1637},
-									exprs: []interface{}{
-										&oneOrMoreExpr{
-											pos: position{line: 70, col: 27, offset: 1637},
-											expr: &ruleRefExpr{
-												pos:  position{line: 70, col: 27, offset: 1637},
-												name: "_",
+					&actionExpr{
+						pos: position{line: 174, col: 5, offset: 5398},
+						run: (*parser).callonQuoted23,
+						expr: &seqExpr{
+							pos: position{line: 174, col: 5, offset: 5398},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 174, col: 5, offset: 5398},
+									val:        "~",
+									ignoreCase: false,
+									want:       "\"~\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 174, col: 9, offset: 5402},
+									label: "any",
+									expr: &choiceExpr{
+										pos: position{line: 174, col: 14, offset: 5407},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 174, col: 14, offset: 5407},
+												name: "Name",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 174, col: 21, offset: 5414},
+												name: "Any",
 											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 70, col: 30, offset: 1640},
-											name: "Any",
 										},
 									},
 								},
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 70, col: 36, offset: 1646},
-							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 36, offset: 1646},
-								name: "_",
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "Map",
-			pos:  position{line: 75, col: 1, offset: 1696},
+			name: "SExpr",
+			pos:  position{line: 179, col: 1, offset: 5474},
 			expr: &choiceExpr{
-				pos: position{line: 75, col: 8, offset: 1705},
+				pos: position{line: 179, col: 10, offset: 5485},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 75, col: 8, offset: 1705},
-						run: (*parser).callonMap2,
+						pos: position{line: 179, col: 10, offset: 5485},
+						run: (*parser).callonSExpr2,
 						expr: &seqExpr{
-							pos: position{line: 75, col: 8, offset: 1705},
+							pos: position{line: 179, col: 10, offset: 5485},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 75, col: 8, offset: 1705},
-									val:        "{",
+									pos:        position{line: 179, col: 10, offset: 5485},
+									val:        "(",
 									ignoreCase: false,
-									want:       "\"{\"",
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 75, col: 12, offset: 1709},
-									expr: &ruleRefExpr{
-										pos:  position{line: 75, col: 12, offset: 1709},
-										name: "_",
-									},
+									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 75, col: 15, offset: 1712},
-									label: "first",
+									pos:   position{line: 179, col: 14, offset: 5489},
+									label: "exp",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 75, col: 21, offset: 1718},
-										expr: &seqExpr{
-											pos: position{line: 75, col: 22, offset: 1719},
-											exprs: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 75, col: 22, offset: 1719},
-													name: "String",
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 75, col: 29, offset: 1726},
-													expr: &ruleRefExpr{
-														pos:  position{line: 75, col: 29, offset: 1726},
-														name: "_",
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 75, col: 32, offset: 1729},
-													val:        ":",
-													ignoreCase: false,
-													want:       "\":\"",
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 75, col: 36, offset: 1733},
-													expr: &ruleRefExpr{
-														pos:  position{line: 75, col: 36, offset: 1733},
-														name: "_",
-													},
-												},
-												&ruleRefExpr{
-													pos:  position{line: 75, col: 39, offset: 1736},
-													name: "Any",
-												},
-											},
+										pos: position{line: 179, col: 18, offset: 5493},
+										expr: &ruleRefExpr{
+											pos:  position{line: 179, col: 18, offset: 5493},
+											name: "Expr",
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 75, col: 45, offset: 1742},
-									label: "rest",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 75, col: 50, offset: 1747},
-										expr: &seqExpr{
-											pos: position{line: 75, col: 51, offset: 1748},
-											exprs: []interface{}{
-												&oneOrMoreExpr{
-													pos: position{line: 75, col: 51, offset: 1748},
-													expr: &ruleRefExpr{
-														pos:  position{line: 75, col: 51, offset: 1748},
-														name: "_",
-													},
-												},
-												&ruleRefExpr{
-													pos:  position{line: 75, col: 54, offset: 1751},
-													name: "String",
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 75, col: 61, offset: 1758},
-													expr: &ruleRefExpr{
-														pos:  position{line: 75, col: 61, offset: 1758},
-														name: "_",
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 75, col: 64, offset: 1761},
-													val:        ":",
-													ignoreCase: false,
-													want:       "\":\"",
+								&litMatcher{
+									pos:        position{line: 179, col: 24, offset: 5499},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 184, col: 5, offset: 5601},
+						run: (*parser).callonSExpr9,
+						expr: &seqExpr{
+							pos: position{line: 184, col: 5, offset: 5601},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 184, col: 5, offset: 5601},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 184, col: 9, offset: 5605},
+									expr: &ruleRefExpr{
+										pos:  position{line: 184, col: 9, offset: 5605},
+										name: "Expr",
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 184, col: 15, offset: 5611},
+									expr: &seqExpr{
+										pos: position{line: 184, col: 16, offset: 5612},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 184, col: 16, offset: 5612},
+												expr: &ruleRefExpr{
+													pos:  position{line: 184, col: 16, offset: 5612},
+													name: "_",
 												},
-												&zeroOrMoreExpr{
-													pos: position{line: 75, col: 68, offset: 1765},
-													expr: &ruleRefExpr{
-														pos:  position{line: 75, col: 68, offset: 1765},
-														name: "_",
-													},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 184, col: 19, offset: 5615},
+												name: "Junk",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 184, col: 24, offset: 5620},
+												expr: &ruleRefExpr{
+													pos:  position{line: 184, col: 24, offset: 5620},
+													name: "_",
 												},
-												&ruleRefExpr{
-													pos:  position{line: 75, col: 71, offset: 1768},
-													name: "Any",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 184, col: 27, offset: 5623},
+												expr: &ruleRefExpr{
+													pos:  position{line: 184, col: 27, offset: 5623},
+													name: "Expr",
 												},
 											},
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 75, col: 77, offset: 1774},
+									pos: position{line: 184, col: 35, offset: 5631},
 									expr: &ruleRefExpr{
-										pos:  position{line: 75, col: 77, offset: 1774},
+										pos:  position{line: 184, col: 35, offset: 5631},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 75, col: 80, offset: 1777},
-									val:        "}",
+									pos:        position{line: 184, col: 38, offset: 5634},
+									val:        ")",
 									ignoreCase: false,
-									want:       "\"}\"",
+									want:       "\")\"",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 77, col: 5, offset: 1835},
-						run: (*parser).callonMap32,
+						pos: position{line: 186, col: 5, offset: 5678},
+						run: (*parser).callonSExpr26,
 						expr: &seqExpr{
-							pos: position{line: 77, col: 5, offset: 1835},
+							pos: position{line: 186, col: 5, offset: 5678},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 77, col: 5, offset: 1835},
-									val:        "{",
+									pos:        position{line: 186, col: 5, offset: 5678},
+									val:        "(",
 									ignoreCase: false,
-									want:       "\"{\"",
+									want:       "\"(\"",
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 77, col: 9, offset: 1839},
+								&zeroOrOneExpr{
+									pos: position{line: 186, col: 9, offset: 5682},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 9, offset: 1839},
-										name: "_",
-									},
-								},
-								&seqExpr{
-									pos: position{line: 77, col: 13, offset: 1843},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 77, col: 13, offset: 1843},
-											name: "String",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 77, col: 20, offset: 1850},
-											expr: &ruleRefExpr{
-												pos:  position{line: 77, col: 20, offset: 1850},
-												name: "_",
-											},
-										},
-										&litMatcher{
-											pos:        position{line: 77, col: 23, offset: 1853},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 77, col: 27, offset: 1857},
-											expr: &ruleRefExpr{
-												pos:  position{line: 77, col: 27, offset: 1857},
-												name: "_",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 77, col: 30, offset: 1860},
-											name: "Any",
-										},
+										pos:  position{line: 186, col: 9, offset: 5682},
+										name: "Expr",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 77, col: 35, offset: 1865},
+									pos: position{line: 186, col: 15, offset: 5688},
 									expr: &seqExpr{
-										pos: position{line: 77, col: 36, offset: 1866},
+										pos: position{line: 186, col: 16, offset: 5689},
 										exprs: []interface{}{
-											&oneOrMoreExpr{
-												pos: position{line: 77, col: 36, offset: 1866},
+											&zeroOrMoreExpr{
+												pos: position{line: 186, col: 16, offset: 5689},
 												expr: &ruleRefExpr{
-													pos:  position{line: 77, col: 36, offset: 1866},
+													pos:  position{line: 186, col: 16, offset: 5689},
 													name: "_",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 77, col: 39, offset: 1869},
-												name: "String",
+												pos:  position{line: 186, col: 19, offset: 5692},
+												name: "Junk",
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 77, col: 46, offset: 1876},
+												pos: position{line: 186, col: 24, offset: 5697},
 												expr: &ruleRefExpr{
-													pos:  position{line: 77, col: 46, offset: 1876},
+													pos:  position{line: 186, col: 24, offset: 5697},
 													name: "_",
 												},
 											},
-											&litMatcher{
-												pos:        position{line: 77, col: 49, offset: 1879},
-												val:        ":",
-												ignoreCase: false,
-												want:       "\":\"",
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 77, col: 53, offset: 1883},
+											&zeroOrOneExpr{
+												pos: position{line: 186, col: 27, offset: 5700},
 												expr: &ruleRefExpr{
-													pos:  position{line: 77, col: 53, offset: 1883},
-													name: "_",
+													pos:  position{line: 186, col: 27, offset: 5700},
+													name: "Expr",
 												},
 											},
-											&ruleRefExpr{
-												pos:  position{line: 77, col: 56, offset: 1886},
-												name: "Any",
-											},
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 77, col: 62, offset: 1892},
+									pos: position{line: 186, col: 35, offset: 5708},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 62, offset: 1892},
+										pos:  position{line: 186, col: 35, offset: 5708},
 										name: "_",
 									},
 								},
 								&notExpr{
-									pos: position{line: 77, col: 65, offset: 1895},
+									pos: position{line: 186, col: 38, offset: 5711},
 									expr: &litMatcher{
-										pos:        position{line: 77, col: 66, offset: 1896},
-										val:        "}",
+										pos:        position{line: 186, col: 39, offset: 5712},
+										val:        ")",
 										ignoreCase: false,
-										want:       "\"}\"",
+										want:       "\")\"",
 									},
 								},
 							},
@@ -890,298 +2673,386 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Symbol",
-			pos:  position{line: 82, col: 1, offset: 1965},
+			name: "Expr",
+			pos:  position{line: 190, col: 1, offset: 5830},
+			expr: &actionExpr{
+				pos: position{line: 190, col: 9, offset: 5840},
+				run: (*parser).callonExpr1,
+				expr: &labeledExpr{
+					pos:   position{line: 190, col: 9, offset: 5840},
+					label: "exp",
+					expr: &choiceExpr{
+						pos: position{line: 190, col: 14, offset: 5845},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 190, col: 14, offset: 5845},
+								name: "Call",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 190, col: 21, offset: 5852},
+								name: "FnExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FnOp",
+			pos:  position{line: 194, col: 1, offset: 5904},
+			expr: &actionExpr{
+				pos: position{line: 194, col: 9, offset: 5914},
+				run: (*parser).callonFnOp1,
+				expr: &litMatcher{
+					pos:        position{line: 194, col: 9, offset: 5914},
+					val:        "=>",
+					ignoreCase: false,
+					want:       "\"=>\"",
+				},
+			},
+		},
+		{
+			name: "FnExpr",
+			pos:  position{line: 197, col: 1, offset: 5942},
 			expr: &actionExpr{
-				pos: position{line: 82, col: 11, offset: 1977},
-				run: (*parser).callonSymbol1,
+				pos: position{line: 197, col: 11, offset: 5954},
+				run: (*parser).callonFnExpr1,
 				expr: &seqExpr{
-					pos: position{line: 82, col: 11, offset: 1977},
+					pos: position{line: 197, col: 11, offset: 5954},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 82, col: 11, offset: 1977},
-							expr: &choiceExpr{
-								pos: position{line: 82, col: 13, offset: 1979},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 82, col: 13, offset: 1979},
-										name: "Null",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 82, col: 20, offset: 1986},
-										name: "Boolean",
-									},
-								},
+						&zeroOrMoreExpr{
+							pos: position{line: 197, col: 11, offset: 5954},
+							expr: &ruleRefExpr{
+								pos:  position{line: 197, col: 11, offset: 5954},
+								name: "_",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 82, col: 29, offset: 1995},
-							name: "word",
+						&labeledExpr{
+							pos:   position{line: 197, col: 14, offset: 5957},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 197, col: 19, offset: 5962},
+								name: "AsExpr",
+							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 82, col: 34, offset: 2000},
-							expr: &choiceExpr{
-								pos: position{line: 82, col: 35, offset: 2001},
-								alternatives: []interface{}{
-									&litMatcher{
-										pos:        position{line: 82, col: 35, offset: 2001},
-										val:        "!",
-										ignoreCase: false,
-										want:       "\"!\"",
-									},
-									&litMatcher{
-										pos:        position{line: 82, col: 41, offset: 2007},
-										val:        "?",
-										ignoreCase: false,
-										want:       "\"?\"",
+						&labeledExpr{
+							pos:   position{line: 197, col: 26, offset: 5969},
+							label: "right",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 197, col: 32, offset: 5975},
+								expr: &seqExpr{
+									pos: position{line: 197, col: 33, offset: 5976},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 197, col: 33, offset: 5976},
+											expr: &ruleRefExpr{
+												pos:  position{line: 197, col: 33, offset: 5976},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 197, col: 36, offset: 5979},
+											name: "FnOp",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 197, col: 41, offset: 5984},
+											expr: &ruleRefExpr{
+												pos:  position{line: 197, col: 41, offset: 5984},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 197, col: 44, offset: 5987},
+											name: "AsExpr",
+										},
 									},
 								},
 							},
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 197, col: 53, offset: 5996},
+							expr: &ruleRefExpr{
+								pos:  position{line: 197, col: 53, offset: 5996},
+								name: "_",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "SExpr",
-			pos:  position{line: 87, col: 1, offset: 2053},
-			expr: &choiceExpr{
-				pos: position{line: 87, col: 10, offset: 2064},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 87, col: 10, offset: 2064},
-						run: (*parser).callonSExpr2,
-						expr: &seqExpr{
-							pos: position{line: 87, col: 10, offset: 2064},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 87, col: 10, offset: 2064},
-									val:        "(",
-									ignoreCase: false,
-									want:       "\"(\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 87, col: 14, offset: 2068},
-									label: "expr",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 87, col: 19, offset: 2073},
-										expr: &ruleRefExpr{
-											pos:  position{line: 87, col: 19, offset: 2073},
-											name: "Expr",
+			name: "AsOp",
+			pos:  position{line: 201, col: 1, offset: 6066},
+			expr: &actionExpr{
+				pos: position{line: 201, col: 9, offset: 6076},
+				run: (*parser).callonAsOp1,
+				expr: &litMatcher{
+					pos:        position{line: 201, col: 9, offset: 6076},
+					val:        ":=",
+					ignoreCase: false,
+					want:       "\":=\"",
+				},
+			},
+		},
+		{
+			name: "AsExpr",
+			pos:  position{line: 204, col: 1, offset: 6104},
+			expr: &actionExpr{
+				pos: position{line: 204, col: 11, offset: 6116},
+				run: (*parser).callonAsExpr1,
+				expr: &seqExpr{
+					pos: position{line: 204, col: 11, offset: 6116},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 204, col: 11, offset: 6116},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 204, col: 16, offset: 6121},
+								name: "PipeExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 204, col: 25, offset: 6130},
+							label: "right",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 204, col: 31, offset: 6136},
+								expr: &seqExpr{
+									pos: position{line: 204, col: 32, offset: 6137},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 204, col: 32, offset: 6137},
+											expr: &ruleRefExpr{
+												pos:  position{line: 204, col: 32, offset: 6137},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 204, col: 35, offset: 6140},
+											name: "AsOp",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 204, col: 40, offset: 6145},
+											expr: &ruleRefExpr{
+												pos:  position{line: 204, col: 40, offset: 6145},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 204, col: 43, offset: 6148},
+											name: "PipeExpr",
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 87, col: 25, offset: 2079},
-									val:        ")",
-									ignoreCase: false,
-									want:       "\")\"",
-								},
 							},
 						},
 					},
+				},
+			},
+		},
+		{
+			name: "PipeOp",
+			pos:  position{line: 208, col: 1, offset: 6324},
+			expr: &actionExpr{
+				pos: position{line: 208, col: 11, offset: 6336},
+				run: (*parser).callonPipeOp1,
+				expr: &choiceExpr{
+					pos: position{line: 208, col: 12, offset: 6337},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 208, col: 12, offset: 6337},
+							val:        "|>>",
+							ignoreCase: false,
+							want:       "\"|>>\"",
+						},
+						&litMatcher{
+							pos:        position{line: 208, col: 20, offset: 6345},
+							val:        "|>",
+							ignoreCase: false,
+							want:       "\"|>\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PipeExpr",
+			pos:  position{line: 211, col: 1, offset: 6374},
+			expr: &choiceExpr{
+				pos: position{line: 211, col: 13, offset: 6388},
+				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 92, col: 5, offset: 2158},
-						run: (*parser).callonSExpr9,
+						pos: position{line: 211, col: 13, offset: 6388},
+						run: (*parser).callonPipeExpr2,
 						expr: &seqExpr{
-							pos: position{line: 92, col: 5, offset: 2158},
+							pos: position{line: 211, col: 13, offset: 6388},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 92, col: 5, offset: 2158},
-									val:        "(",
-									ignoreCase: false,
-									want:       "\"(\"",
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 92, col: 9, offset: 2162},
-									expr: &ruleRefExpr{
-										pos:  position{line: 92, col: 9, offset: 2162},
-										name: "Expr",
+								&labeledExpr{
+									pos:   position{line: 211, col: 13, offset: 6388},
+									label: "left",
+									expr: &choiceExpr{
+										pos: position{line: 211, col: 19, offset: 6394},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 211, col: 19, offset: 6394},
+												name: "Seq",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 211, col: 25, offset: 6400},
+												name: "CondExpr",
+											},
+										},
 									},
 								},
-								&notExpr{
-									pos: position{line: 92, col: 15, offset: 2168},
-									expr: &litMatcher{
-										pos:        position{line: 92, col: 16, offset: 2169},
-										val:        ")",
-										ignoreCase: false,
-										want:       "\")\"",
+								&labeledExpr{
+									pos:   position{line: 211, col: 35, offset: 6410},
+									label: "right",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 211, col: 41, offset: 6416},
+										expr: &seqExpr{
+											pos: position{line: 211, col: 42, offset: 6417},
+											exprs: []interface{}{
+												&zeroOrMoreExpr{
+													pos: position{line: 211, col: 42, offset: 6417},
+													expr: &ruleRefExpr{
+														pos:  position{line: 211, col: 42, offset: 6417},
+														name: "_",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 211, col: 45, offset: 6420},
+													name: "PipeOp",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 211, col: 52, offset: 6427},
+													expr: &ruleRefExpr{
+														pos:  position{line: 211, col: 52, offset: 6427},
+														name: "_",
+													},
+												},
+												&choiceExpr{
+													pos: position{line: 211, col: 56, offset: 6431},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 211, col: 56, offset: 6431},
+															name: "Seq",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 211, col: 62, offset: 6437},
+															name: "Lone",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 211, col: 69, offset: 6444},
+															name: "CondExpr",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "Expr",
-			pos:  position{line: 96, col: 1, offset: 2280},
-			expr: &actionExpr{
-				pos: position{line: 96, col: 9, offset: 2290},
-				run: (*parser).callonExpr1,
-				expr: &labeledExpr{
-					pos:   position{line: 96, col: 9, offset: 2290},
-					label: "expr",
-					expr: &choiceExpr{
-						pos: position{line: 96, col: 15, offset: 2296},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 96, col: 15, offset: 2296},
-								name: "Seq",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 96, col: 21, offset: 2302},
-								name: "FnExpr",
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 213, col: 5, offset: 6500},
+						name: "CondExpr",
 					},
 				},
 			},
 		},
 		{
-			name: "FnOp",
-			pos:  position{line: 100, col: 1, offset: 2361},
+			name: "CondOp",
+			pos:  position{line: 215, col: 1, offset: 6544},
 			expr: &actionExpr{
-				pos: position{line: 100, col: 9, offset: 2371},
-				run: (*parser).callonFnOp1,
+				pos: position{line: 215, col: 11, offset: 6556},
+				run: (*parser).callonCondOp1,
 				expr: &litMatcher{
-					pos:        position{line: 100, col: 9, offset: 2371},
-					val:        "=>",
+					pos:        position{line: 215, col: 11, offset: 6556},
+					val:        "?",
 					ignoreCase: false,
-					want:       "\"=>\"",
+					want:       "\"?\"",
 				},
 			},
 		},
 		{
-			name: "FnExpr",
-			pos:  position{line: 103, col: 1, offset: 2399},
+			name: "CondExpr",
+			pos:  position{line: 218, col: 1, offset: 6583},
 			expr: &actionExpr{
-				pos: position{line: 103, col: 11, offset: 2411},
-				run: (*parser).callonFnExpr1,
+				pos: position{line: 218, col: 13, offset: 6597},
+				run: (*parser).callonCondExpr1,
 				expr: &seqExpr{
-					pos: position{line: 103, col: 11, offset: 2411},
+					pos: position{line: 218, col: 13, offset: 6597},
 					exprs: []interface{}{
-						&zeroOrMoreExpr{
-							pos: position{line: 103, col: 11, offset: 2411},
-							expr: &ruleRefExpr{
-								pos:  position{line: 103, col: 11, offset: 2411},
-								name: "_",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 103, col: 14, offset: 2414},
+							pos:   position{line: 218, col: 13, offset: 6597},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 103, col: 19, offset: 2419},
-								name: "AsExpr",
+								pos:  position{line: 218, col: 18, offset: 6602},
+								name: "OrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 103, col: 26, offset: 2426},
+							pos:   position{line: 218, col: 25, offset: 6609},
 							label: "right",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 103, col: 32, offset: 2432},
+								pos: position{line: 218, col: 31, offset: 6615},
 								expr: &seqExpr{
-									pos: position{line: 103, col: 33, offset: 2433},
+									pos: position{line: 218, col: 32, offset: 6616},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 103, col: 33, offset: 2433},
+											pos: position{line: 218, col: 32, offset: 6616},
 											expr: &ruleRefExpr{
-												pos:  position{line: 103, col: 33, offset: 2433},
+												pos:  position{line: 218, col: 32, offset: 6616},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 103, col: 36, offset: 2436},
-											name: "FnOp",
+											pos:  position{line: 218, col: 35, offset: 6619},
+											name: "CondOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 103, col: 41, offset: 2441},
+											pos: position{line: 218, col: 42, offset: 6626},
 											expr: &ruleRefExpr{
-												pos:  position{line: 103, col: 41, offset: 2441},
+												pos:  position{line: 218, col: 42, offset: 6626},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 103, col: 44, offset: 2444},
-											name: "AsExpr",
+											pos:  position{line: 218, col: 45, offset: 6629},
+											name: "CondExpr",
 										},
-									},
-								},
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 103, col: 53, offset: 2453},
-							expr: &ruleRefExpr{
-								pos:  position{line: 103, col: 53, offset: 2453},
-								name: "_",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AsOp",
-			pos:  position{line: 107, col: 1, offset: 2523},
-			expr: &actionExpr{
-				pos: position{line: 107, col: 9, offset: 2533},
-				run: (*parser).callonAsOp1,
-				expr: &litMatcher{
-					pos:        position{line: 107, col: 9, offset: 2533},
-					val:        ":=",
-					ignoreCase: false,
-					want:       "\":=\"",
-				},
-			},
-		},
-		{
-			name: "AsExpr",
-			pos:  position{line: 110, col: 1, offset: 2561},
-			expr: &actionExpr{
-				pos: position{line: 110, col: 11, offset: 2573},
-				run: (*parser).callonAsExpr1,
-				expr: &seqExpr{
-					pos: position{line: 110, col: 11, offset: 2573},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 110, col: 11, offset: 2573},
-							label: "left",
-							expr: &ruleRefExpr{
-								pos:  position{line: 110, col: 16, offset: 2578},
-								name: "OrExpr",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 110, col: 23, offset: 2585},
-							label: "right",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 110, col: 29, offset: 2591},
-								expr: &seqExpr{
-									pos: position{line: 110, col: 30, offset: 2592},
-									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 110, col: 30, offset: 2592},
+											pos: position{line: 218, col: 54, offset: 6638},
 											expr: &ruleRefExpr{
-												pos:  position{line: 110, col: 30, offset: 2592},
+												pos:  position{line: 218, col: 54, offset: 6638},
 												name: "_",
 											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 110, col: 33, offset: 2595},
-											name: "AsOp",
+										&litMatcher{
+											pos:        position{line: 218, col: 57, offset: 6641},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+										&notExpr{
+											pos: position{line: 218, col: 61, offset: 6645},
+											expr: &litMatcher{
+												pos:        position{line: 218, col: 62, offset: 6646},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 110, col: 38, offset: 2600},
+											pos: position{line: 218, col: 66, offset: 6650},
 											expr: &ruleRefExpr{
-												pos:  position{line: 110, col: 38, offset: 2600},
+												pos:  position{line: 218, col: 66, offset: 6650},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 110, col: 41, offset: 2603},
-											name: "OrExpr",
+											pos:  position{line: 218, col: 69, offset: 6653},
+											name: "CondExpr",
 										},
 									},
 								},
@@ -1193,12 +3064,12 @@ var g = &grammar{
 		},
 		{
 			name: "OrOp",
-			pos:  position{line: 114, col: 1, offset: 2675},
+			pos:  position{line: 222, col: 1, offset: 6718},
 			expr: &actionExpr{
-				pos: position{line: 114, col: 9, offset: 2685},
+				pos: position{line: 222, col: 9, offset: 6728},
 				run: (*parser).callonOrOp1,
 				expr: &litMatcher{
-					pos:        position{line: 114, col: 9, offset: 2685},
+					pos:        position{line: 222, col: 9, offset: 6728},
 					val:        "||",
 					ignoreCase: false,
 					want:       "\"||\"",
@@ -1207,49 +3078,49 @@ var g = &grammar{
 		},
 		{
 			name: "OrExpr",
-			pos:  position{line: 117, col: 1, offset: 2713},
+			pos:  position{line: 225, col: 1, offset: 6756},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 11, offset: 2725},
+				pos: position{line: 225, col: 11, offset: 6768},
 				run: (*parser).callonOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 117, col: 11, offset: 2725},
+					pos: position{line: 225, col: 11, offset: 6768},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 117, col: 11, offset: 2725},
+							pos:   position{line: 225, col: 11, offset: 6768},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 117, col: 16, offset: 2730},
+								pos:  position{line: 225, col: 16, offset: 6773},
 								name: "AndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 117, col: 24, offset: 2738},
+							pos:   position{line: 225, col: 24, offset: 6781},
 							label: "right",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 117, col: 30, offset: 2744},
+								pos: position{line: 225, col: 30, offset: 6787},
 								expr: &seqExpr{
-									pos: position{line: 117, col: 31, offset: 2745},
+									pos: position{line: 225, col: 31, offset: 6788},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 117, col: 31, offset: 2745},
+											pos: position{line: 225, col: 31, offset: 6788},
 											expr: &ruleRefExpr{
-												pos:  position{line: 117, col: 31, offset: 2745},
+												pos:  position{line: 225, col: 31, offset: 6788},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 117, col: 34, offset: 2748},
+											pos:  position{line: 225, col: 34, offset: 6791},
 											name: "OrOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 117, col: 39, offset: 2753},
+											pos: position{line: 225, col: 39, offset: 6796},
 											expr: &ruleRefExpr{
-												pos:  position{line: 117, col: 39, offset: 2753},
+												pos:  position{line: 225, col: 39, offset: 6796},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 117, col: 42, offset: 2756},
+											pos:  position{line: 225, col: 42, offset: 6799},
 											name: "AndExpr",
 										},
 									},
@@ -1262,12 +3133,12 @@ var g = &grammar{
 		},
 		{
 			name: "AndOp",
-			pos:  position{line: 121, col: 1, offset: 2815},
+			pos:  position{line: 229, col: 1, offset: 6858},
 			expr: &actionExpr{
-				pos: position{line: 121, col: 10, offset: 2826},
+				pos: position{line: 229, col: 10, offset: 6869},
 				run: (*parser).callonAndOp1,
 				expr: &litMatcher{
-					pos:        position{line: 121, col: 10, offset: 2826},
+					pos:        position{line: 229, col: 10, offset: 6869},
 					val:        "&&",
 					ignoreCase: false,
 					want:       "\"&&\"",
@@ -1276,49 +3147,49 @@ var g = &grammar{
 		},
 		{
 			name: "AndExpr",
-			pos:  position{line: 124, col: 1, offset: 2854},
+			pos:  position{line: 232, col: 1, offset: 6897},
 			expr: &actionExpr{
-				pos: position{line: 124, col: 12, offset: 2867},
+				pos: position{line: 232, col: 12, offset: 6910},
 				run: (*parser).callonAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 124, col: 12, offset: 2867},
+					pos: position{line: 232, col: 12, offset: 6910},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 124, col: 12, offset: 2867},
+							pos:   position{line: 232, col: 12, offset: 6910},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 124, col: 17, offset: 2872},
+								pos:  position{line: 232, col: 17, offset: 6915},
 								name: "EqlExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 124, col: 25, offset: 2880},
+							pos:   position{line: 232, col: 25, offset: 6923},
 							label: "right",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 124, col: 31, offset: 2886},
+								pos: position{line: 232, col: 31, offset: 6929},
 								expr: &seqExpr{
-									pos: position{line: 124, col: 32, offset: 2887},
+									pos: position{line: 232, col: 32, offset: 6930},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 124, col: 32, offset: 2887},
+											pos: position{line: 232, col: 32, offset: 6930},
 											expr: &ruleRefExpr{
-												pos:  position{line: 124, col: 32, offset: 2887},
+												pos:  position{line: 232, col: 32, offset: 6930},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 124, col: 35, offset: 2890},
+											pos:  position{line: 232, col: 35, offset: 6933},
 											name: "AndOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 124, col: 41, offset: 2896},
+											pos: position{line: 232, col: 41, offset: 6939},
 											expr: &ruleRefExpr{
-												pos:  position{line: 124, col: 41, offset: 2896},
+												pos:  position{line: 232, col: 41, offset: 6939},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 124, col: 44, offset: 2899},
+											pos:  position{line: 232, col: 44, offset: 6942},
 											name: "EqlExpr",
 										},
 									},
@@ -1331,21 +3202,21 @@ var g = &grammar{
 		},
 		{
 			name: "EqlOp",
-			pos:  position{line: 128, col: 1, offset: 2963},
+			pos:  position{line: 236, col: 1, offset: 7006},
 			expr: &actionExpr{
-				pos: position{line: 128, col: 10, offset: 2974},
+				pos: position{line: 236, col: 10, offset: 7017},
 				run: (*parser).callonEqlOp1,
 				expr: &choiceExpr{
-					pos: position{line: 128, col: 11, offset: 2975},
+					pos: position{line: 236, col: 11, offset: 7018},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 128, col: 11, offset: 2975},
+							pos:        position{line: 236, col: 11, offset: 7018},
 							val:        "==",
 							ignoreCase: false,
 							want:       "\"==\"",
 						},
 						&litMatcher{
-							pos:        position{line: 128, col: 18, offset: 2982},
+							pos:        position{line: 236, col: 18, offset: 7025},
 							val:        "!=",
 							ignoreCase: false,
 							want:       "\"!=\"",
@@ -1356,49 +3227,49 @@ var g = &grammar{
 		},
 		{
 			name: "EqlExpr",
-			pos:  position{line: 131, col: 1, offset: 3011},
+			pos:  position{line: 239, col: 1, offset: 7054},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 12, offset: 3024},
+				pos: position{line: 239, col: 12, offset: 7067},
 				run: (*parser).callonEqlExpr1,
 				expr: &seqExpr{
-					pos: position{line: 131, col: 12, offset: 3024},
+					pos: position{line: 239, col: 12, offset: 7067},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 131, col: 12, offset: 3024},
+							pos:   position{line: 239, col: 12, offset: 7067},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 131, col: 17, offset: 3029},
+								pos:  position{line: 239, col: 17, offset: 7072},
 								name: "CmpExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 131, col: 25, offset: 3037},
+							pos:   position{line: 239, col: 25, offset: 7080},
 							label: "right",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 131, col: 31, offset: 3043},
+								pos: position{line: 239, col: 31, offset: 7086},
 								expr: &seqExpr{
-									pos: position{line: 131, col: 32, offset: 3044},
+									pos: position{line: 239, col: 32, offset: 7087},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 131, col: 32, offset: 3044},
+											pos: position{line: 239, col: 32, offset: 7087},
 											expr: &ruleRefExpr{
-												pos:  position{line: 131, col: 32, offset: 3044},
+												pos:  position{line: 239, col: 32, offset: 7087},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 131, col: 35, offset: 3047},
+											pos:  position{line: 239, col: 35, offset: 7090},
 											name: "EqlOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 131, col: 41, offset: 3053},
+											pos: position{line: 239, col: 41, offset: 7096},
 											expr: &ruleRefExpr{
-												pos:  position{line: 131, col: 41, offset: 3053},
+												pos:  position{line: 239, col: 41, offset: 7096},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 131, col: 44, offset: 3056},
+											pos:  position{line: 239, col: 44, offset: 7099},
 											name: "CmpExpr",
 										},
 									},
@@ -1411,33 +3282,33 @@ var g = &grammar{
 		},
 		{
 			name: "CmpOp",
-			pos:  position{line: 135, col: 1, offset: 3138},
+			pos:  position{line: 243, col: 1, offset: 7181},
 			expr: &actionExpr{
-				pos: position{line: 135, col: 10, offset: 3149},
+				pos: position{line: 243, col: 10, offset: 7192},
 				run: (*parser).callonCmpOp1,
 				expr: &choiceExpr{
-					pos: position{line: 135, col: 11, offset: 3150},
+					pos: position{line: 243, col: 11, offset: 7193},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 135, col: 11, offset: 3150},
+							pos:        position{line: 243, col: 11, offset: 7193},
 							val:        "<=",
 							ignoreCase: false,
 							want:       "\"<=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 135, col: 18, offset: 3157},
+							pos:        position{line: 243, col: 18, offset: 7200},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&litMatcher{
-							pos:        position{line: 135, col: 24, offset: 3163},
+							pos:        position{line: 243, col: 24, offset: 7206},
 							val:        ">=",
 							ignoreCase: false,
 							want:       "\">=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 135, col: 31, offset: 3170},
+							pos:        position{line: 243, col: 31, offset: 7213},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -1448,49 +3319,49 @@ var g = &grammar{
 		},
 		{
 			name: "CmpExpr",
-			pos:  position{line: 138, col: 1, offset: 3198},
+			pos:  position{line: 246, col: 1, offset: 7241},
 			expr: &actionExpr{
-				pos: position{line: 138, col: 12, offset: 3211},
+				pos: position{line: 246, col: 12, offset: 7254},
 				run: (*parser).callonCmpExpr1,
 				expr: &seqExpr{
-					pos: position{line: 138, col: 12, offset: 3211},
+					pos: position{line: 246, col: 12, offset: 7254},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 138, col: 12, offset: 3211},
+							pos:   position{line: 246, col: 12, offset: 7254},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 17, offset: 3216},
+								pos:  position{line: 246, col: 17, offset: 7259},
 								name: "AddExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 138, col: 25, offset: 3224},
+							pos:   position{line: 246, col: 25, offset: 7267},
 							label: "right",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 138, col: 31, offset: 3230},
+								pos: position{line: 246, col: 31, offset: 7273},
 								expr: &seqExpr{
-									pos: position{line: 138, col: 32, offset: 3231},
+									pos: position{line: 246, col: 32, offset: 7274},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 138, col: 32, offset: 3231},
+											pos: position{line: 246, col: 32, offset: 7274},
 											expr: &ruleRefExpr{
-												pos:  position{line: 138, col: 32, offset: 3231},
+												pos:  position{line: 246, col: 32, offset: 7274},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 138, col: 35, offset: 3234},
+											pos:  position{line: 246, col: 35, offset: 7277},
 											name: "CmpOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 138, col: 41, offset: 3240},
+											pos: position{line: 246, col: 41, offset: 7283},
 											expr: &ruleRefExpr{
-												pos:  position{line: 138, col: 41, offset: 3240},
+												pos:  position{line: 246, col: 41, offset: 7283},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 138, col: 44, offset: 3243},
+											pos:  position{line: 246, col: 44, offset: 7286},
 											name: "AddExpr",
 										},
 									},
@@ -1503,21 +3374,21 @@ var g = &grammar{
 		},
 		{
 			name: "AddOp",
-			pos:  position{line: 142, col: 1, offset: 3328},
+			pos:  position{line: 250, col: 1, offset: 7371},
 			expr: &actionExpr{
-				pos: position{line: 142, col: 10, offset: 3339},
+				pos: position{line: 250, col: 10, offset: 7382},
 				run: (*parser).callonAddOp1,
 				expr: &choiceExpr{
-					pos: position{line: 142, col: 11, offset: 3340},
+					pos: position{line: 250, col: 11, offset: 7383},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 142, col: 11, offset: 3340},
+							pos:        position{line: 250, col: 11, offset: 7383},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 142, col: 17, offset: 3346},
+							pos:        position{line: 250, col: 17, offset: 7389},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -1528,49 +3399,49 @@ var g = &grammar{
 		},
 		{
 			name: "AddExpr",
-			pos:  position{line: 145, col: 1, offset: 3374},
+			pos:  position{line: 253, col: 1, offset: 7417},
 			expr: &actionExpr{
-				pos: position{line: 145, col: 12, offset: 3387},
+				pos: position{line: 253, col: 12, offset: 7430},
 				run: (*parser).callonAddExpr1,
 				expr: &seqExpr{
-					pos: position{line: 145, col: 12, offset: 3387},
+					pos: position{line: 253, col: 12, offset: 7430},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 145, col: 12, offset: 3387},
+							pos:   position{line: 253, col: 12, offset: 7430},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 145, col: 17, offset: 3392},
+								pos:  position{line: 253, col: 17, offset: 7435},
 								name: "MulExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 145, col: 25, offset: 3400},
+							pos:   position{line: 253, col: 25, offset: 7443},
 							label: "right",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 145, col: 31, offset: 3406},
+								pos: position{line: 253, col: 31, offset: 7449},
 								expr: &seqExpr{
-									pos: position{line: 145, col: 32, offset: 3407},
+									pos: position{line: 253, col: 32, offset: 7450},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 145, col: 32, offset: 3407},
+											pos: position{line: 253, col: 32, offset: 7450},
 											expr: &ruleRefExpr{
-												pos:  position{line: 145, col: 32, offset: 3407},
+												pos:  position{line: 253, col: 32, offset: 7450},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 145, col: 35, offset: 3410},
+											pos:  position{line: 253, col: 35, offset: 7453},
 											name: "AddOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 145, col: 41, offset: 3416},
+											pos: position{line: 253, col: 41, offset: 7459},
 											expr: &ruleRefExpr{
-												pos:  position{line: 145, col: 41, offset: 3416},
+												pos:  position{line: 253, col: 41, offset: 7459},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 145, col: 44, offset: 3419},
+											pos:  position{line: 253, col: 44, offset: 7462},
 											name: "MulExpr",
 										},
 									},
@@ -1583,74 +3454,94 @@ var g = &grammar{
 		},
 		{
 			name: "MulOp",
-			pos:  position{line: 149, col: 1, offset: 3492},
+			pos:  position{line: 257, col: 1, offset: 7544},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 10, offset: 3503},
+				pos: position{line: 257, col: 10, offset: 7555},
 				run: (*parser).callonMulOp1,
 				expr: &choiceExpr{
-					pos: position{line: 149, col: 11, offset: 3504},
+					pos: position{line: 257, col: 11, offset: 7556},
 					alternatives: []interface{}{
-						&litMatcher{
-							pos:        position{line: 149, col: 11, offset: 3504},
-							val:        "*",
-							ignoreCase: false,
-							want:       "\"*\"",
+						&seqExpr{
+							pos: position{line: 257, col: 11, offset: 7556},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 257, col: 11, offset: 7556},
+									val:        "*",
+									ignoreCase: false,
+									want:       "\"*\"",
+								},
+								&notExpr{
+									pos: position{line: 257, col: 15, offset: 7560},
+									expr: &litMatcher{
+										pos:        position{line: 257, col: 16, offset: 7561},
+										val:        "*",
+										ignoreCase: false,
+										want:       "\"*\"",
+									},
+								},
+							},
 						},
 						&litMatcher{
-							pos:        position{line: 149, col: 17, offset: 3510},
+							pos:        position{line: 257, col: 22, offset: 7567},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
+						&litMatcher{
+							pos:        position{line: 257, col: 28, offset: 7573},
+							val:        "%",
+							ignoreCase: false,
+							want:       "\"%\"",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "MulExpr",
-			pos:  position{line: 152, col: 1, offset: 3538},
+			pos:  position{line: 260, col: 1, offset: 7601},
 			expr: &actionExpr{
-				pos: position{line: 152, col: 12, offset: 3551},
+				pos: position{line: 260, col: 12, offset: 7614},
 				run: (*parser).callonMulExpr1,
 				expr: &seqExpr{
-					pos: position{line: 152, col: 12, offset: 3551},
+					pos: position{line: 260, col: 12, offset: 7614},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 152, col: 12, offset: 3551},
+							pos:   position{line: 260, col: 12, offset: 7614},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 152, col: 17, offset: 3556},
+								pos:  position{line: 260, col: 17, offset: 7619},
 								name: "Unary",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 152, col: 23, offset: 3562},
+							pos:   position{line: 260, col: 23, offset: 7625},
 							label: "right",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 152, col: 29, offset: 3568},
+								pos: position{line: 260, col: 29, offset: 7631},
 								expr: &seqExpr{
-									pos: position{line: 152, col: 30, offset: 3569},
+									pos: position{line: 260, col: 30, offset: 7632},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 152, col: 30, offset: 3569},
+											pos: position{line: 260, col: 30, offset: 7632},
 											expr: &ruleRefExpr{
-												pos:  position{line: 152, col: 30, offset: 3569},
+												pos:  position{line: 260, col: 30, offset: 7632},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 152, col: 33, offset: 3572},
+											pos:  position{line: 260, col: 33, offset: 7635},
 											name: "MulOp",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 152, col: 39, offset: 3578},
+											pos: position{line: 260, col: 39, offset: 7641},
 											expr: &ruleRefExpr{
-												pos:  position{line: 152, col: 39, offset: 3578},
+												pos:  position{line: 260, col: 39, offset: 7641},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 152, col: 42, offset: 3581},
+											pos:  position{line: 260, col: 42, offset: 7644},
 											name: "Unary",
 										},
 									},
@@ -1663,78 +3554,382 @@ var g = &grammar{
 		},
 		{
 			name: "UnaOp",
-			pos:  position{line: 156, col: 1, offset: 3640},
+			pos:  position{line: 264, col: 1, offset: 7763},
 			expr: &actionExpr{
-				pos: position{line: 156, col: 10, offset: 3651},
+				pos: position{line: 264, col: 10, offset: 7774},
 				run: (*parser).callonUnaOp1,
+				expr: &choiceExpr{
+					pos: position{line: 264, col: 11, offset: 7775},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 264, col: 11, offset: 7775},
+							val:        "!",
+							ignoreCase: false,
+							want:       "\"!\"",
+						},
+						&seqExpr{
+							pos: position{line: 264, col: 17, offset: 7781},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 264, col: 17, offset: 7781},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+								&notExpr{
+									pos: position{line: 264, col: 21, offset: 7785},
+									expr: &ruleRefExpr{
+										pos:  position{line: 264, col: 22, offset: 7786},
+										name: "digit",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Unary",
+			pos:  position{line: 267, col: 1, offset: 7816},
+			expr: &choiceExpr{
+				pos: position{line: 267, col: 10, offset: 7827},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 267, col: 10, offset: 7827},
+						run: (*parser).callonUnary2,
+						expr: &seqExpr{
+							pos: position{line: 267, col: 10, offset: 7827},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 267, col: 10, offset: 7827},
+									label: "uop",
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 14, offset: 7831},
+										name: "UnaOp",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 267, col: 20, offset: 7837},
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 20, offset: 7837},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 267, col: 23, offset: 7840},
+									label: "operand",
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 31, offset: 7848},
+										name: "Unary",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 269, col: 5, offset: 7919},
+						name: "PowExpr",
+					},
+				},
+			},
+		},
+		{
+			name: "PowOp",
+			pos:  position{line: 271, col: 1, offset: 8005},
+			expr: &actionExpr{
+				pos: position{line: 271, col: 10, offset: 8016},
+				run: (*parser).callonPowOp1,
 				expr: &litMatcher{
-					pos:        position{line: 156, col: 10, offset: 3651},
-					val:        "!",
+					pos:        position{line: 271, col: 10, offset: 8016},
+					val:        "**",
 					ignoreCase: false,
-					want:       "\"!\"",
+					want:       "\"**\"",
 				},
 			},
 		},
 		{
-			name: "Unary",
-			pos:  position{line: 159, col: 1, offset: 3678},
+			name: "PowExpr",
+			pos:  position{line: 274, col: 1, offset: 8044},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 10, offset: 3689},
-				run: (*parser).callonUnary1,
+				pos: position{line: 274, col: 12, offset: 8057},
+				run: (*parser).callonPowExpr1,
 				expr: &seqExpr{
-					pos: position{line: 159, col: 10, offset: 3689},
+					pos: position{line: 274, col: 12, offset: 8057},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 159, col: 10, offset: 3689},
-							label: "uop",
+							pos:   position{line: 274, col: 12, offset: 8057},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 17, offset: 8062},
+								name: "Postfix",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 25, offset: 8070},
+							label: "right",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 159, col: 14, offset: 3693},
-								expr: &ruleRefExpr{
-									pos:  position{line: 159, col: 14, offset: 3693},
-									name: "UnaOp",
+								pos: position{line: 274, col: 31, offset: 8076},
+								expr: &seqExpr{
+									pos: position{line: 274, col: 32, offset: 8077},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 274, col: 32, offset: 8077},
+											expr: &ruleRefExpr{
+												pos:  position{line: 274, col: 32, offset: 8077},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 274, col: 35, offset: 8080},
+											name: "PowOp",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 274, col: 41, offset: 8086},
+											expr: &ruleRefExpr{
+												pos:  position{line: 274, col: 41, offset: 8086},
+												name: "_",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 274, col: 44, offset: 8089},
+											name: "Unary",
+										},
+									},
 								},
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 159, col: 21, offset: 3700},
-							expr: &ruleRefExpr{
-								pos:  position{line: 159, col: 21, offset: 3700},
-								name: "_",
+					},
+				},
+			},
+		},
+		{
+			name: "Junk",
+			pos:  position{line: 284, col: 1, offset: 8344},
+			expr: &actionExpr{
+				pos: position{line: 284, col: 9, offset: 8354},
+				run: (*parser).callonJunk1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 284, col: 9, offset: 8354},
+					expr: &choiceExpr{
+						pos: position{line: 284, col: 10, offset: 8355},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 284, col: 10, offset: 8355},
+								name: "Nested",
+							},
+							&seqExpr{
+								pos: position{line: 284, col: 19, offset: 8364},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 284, col: 19, offset: 8364},
+										expr: &ruleRefExpr{
+											pos:  position{line: 284, col: 20, offset: 8365},
+											name: "Closer",
+										},
+									},
+									&notExpr{
+										pos: position{line: 284, col: 27, offset: 8372},
+										expr: &ruleRefExpr{
+											pos:  position{line: 284, col: 28, offset: 8373},
+											name: "_",
+										},
+									},
+									&anyMatcher{
+										line: 284, col: 30, offset: 8375,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Nested",
+			pos:  position{line: 287, col: 1, offset: 8411},
+			expr: &choiceExpr{
+				pos: position{line: 287, col: 11, offset: 8423},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 287, col: 11, offset: 8423},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 287, col: 11, offset: 8423},
+								val:        "(",
+								ignoreCase: false,
+								want:       "\"(\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 287, col: 15, offset: 8427},
+								expr: &choiceExpr{
+									pos: position{line: 287, col: 16, offset: 8428},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 287, col: 16, offset: 8428},
+											name: "Nested",
+										},
+										&seqExpr{
+											pos: position{line: 287, col: 25, offset: 8437},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 287, col: 25, offset: 8437},
+													expr: &ruleRefExpr{
+														pos:  position{line: 287, col: 26, offset: 8438},
+														name: "Closer",
+													},
+												},
+												&anyMatcher{
+													line: 287, col: 33, offset: 8445,
+												},
+											},
+										},
+									},
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 287, col: 37, offset: 8449},
+								val:        ")",
+								ignoreCase: false,
+								want:       "\")\"",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 287, col: 43, offset: 8455},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 287, col: 43, offset: 8455},
+								val:        "[",
+								ignoreCase: false,
+								want:       "\"[\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 287, col: 47, offset: 8459},
+								expr: &choiceExpr{
+									pos: position{line: 287, col: 48, offset: 8460},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 287, col: 48, offset: 8460},
+											name: "Nested",
+										},
+										&seqExpr{
+											pos: position{line: 287, col: 57, offset: 8469},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 287, col: 57, offset: 8469},
+													expr: &ruleRefExpr{
+														pos:  position{line: 287, col: 58, offset: 8470},
+														name: "Closer",
+													},
+												},
+												&anyMatcher{
+													line: 287, col: 65, offset: 8477,
+												},
+											},
+										},
+									},
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 287, col: 69, offset: 8481},
+								val:        "]",
+								ignoreCase: false,
+								want:       "\"]\"",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 287, col: 75, offset: 8487},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 287, col: 75, offset: 8487},
+								val:        "{",
+								ignoreCase: false,
+								want:       "\"{\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 287, col: 79, offset: 8491},
+								expr: &choiceExpr{
+									pos: position{line: 287, col: 80, offset: 8492},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 287, col: 80, offset: 8492},
+											name: "Nested",
+										},
+										&seqExpr{
+											pos: position{line: 287, col: 89, offset: 8501},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 287, col: 89, offset: 8501},
+													expr: &ruleRefExpr{
+														pos:  position{line: 287, col: 90, offset: 8502},
+														name: "Closer",
+													},
+												},
+												&anyMatcher{
+													line: 287, col: 97, offset: 8509,
+												},
+											},
+										},
+									},
+								},
 							},
-						},
-						&labeledExpr{
-							pos:   position{line: 159, col: 24, offset: 3703},
-							label: "any",
-							expr: &ruleRefExpr{
-								pos:  position{line: 159, col: 28, offset: 3707},
-								name: "Any",
+							&litMatcher{
+								pos:        position{line: 287, col: 101, offset: 8513},
+								val:        "}",
+								ignoreCase: false,
+								want:       "\"}\"",
 							},
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "Closer",
+			pos:  position{line: 288, col: 1, offset: 8517},
+			expr: &charClassMatcher{
+				pos:        position{line: 288, col: 11, offset: 8529},
+				val:        "[)\\]}]",
+				chars:      []rune{')', ']', '}'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "Stray",
+			pos:  position{line: 290, col: 1, offset: 8578},
+			expr: &actionExpr{
+				pos: position{line: 290, col: 10, offset: 8589},
+				run: (*parser).callonStray1,
+				expr: &ruleRefExpr{
+					pos:  position{line: 290, col: 10, offset: 8589},
+					name: "Closer",
+				},
+			},
+		},
 		{
 			name: "word",
-			pos:  position{line: 168, col: 1, offset: 3873},
+			pos:  position{line: 295, col: 1, offset: 8649},
 			expr: &seqExpr{
-				pos: position{line: 168, col: 9, offset: 3883},
+				pos: position{line: 295, col: 9, offset: 8659},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 168, col: 9, offset: 3883},
+						pos:  position{line: 295, col: 9, offset: 8659},
 						name: "letter",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 168, col: 16, offset: 3890},
+						pos: position{line: 295, col: 16, offset: 8666},
 						expr: &choiceExpr{
-							pos: position{line: 168, col: 17, offset: 3891},
+							pos: position{line: 295, col: 17, offset: 8667},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 168, col: 17, offset: 3891},
+									pos:  position{line: 295, col: 17, offset: 8667},
 									name: "letter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 168, col: 26, offset: 3900},
+									pos:  position{line: 295, col: 26, offset: 8676},
 									name: "digit",
 								},
 							},
@@ -1743,21 +3938,45 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "symChr",
+			pos:  position{line: 297, col: 1, offset: 8746},
+			expr: &choiceExpr{
+				pos: position{line: 297, col: 11, offset: 8758},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 297, col: 11, offset: 8758},
+						name: "letter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 297, col: 20, offset: 8767},
+						name: "digit",
+					},
+					&charClassMatcher{
+						pos:        position{line: 297, col: 28, offset: 8775},
+						val:        "[-!?]",
+						chars:      []rune{'-', '!', '?'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+				},
+			},
+		},
 		{
 			name: "letter",
-			pos:  position{line: 170, col: 1, offset: 3941},
+			pos:  position{line: 299, col: 1, offset: 8814},
 			expr: &choiceExpr{
-				pos: position{line: 170, col: 11, offset: 3953},
+				pos: position{line: 299, col: 11, offset: 8826},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 170, col: 11, offset: 3953},
+						pos:        position{line: 299, col: 11, offset: 8826},
 						val:        "[\\p{L}]",
 						classes:    []*unicode.RangeTable{rangeTable("L")},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&litMatcher{
-						pos:        position{line: 170, col: 21, offset: 3963},
+						pos:        position{line: 299, col: 21, offset: 8836},
 						val:        "_",
 						ignoreCase: false,
 						want:       "\"_\"",
@@ -1767,9 +3986,9 @@ var g = &grammar{
 		},
 		{
 			name: "digit",
-			pos:  position{line: 172, col: 1, offset: 3979},
+			pos:  position{line: 301, col: 1, offset: 8852},
 			expr: &charClassMatcher{
-				pos:        position{line: 172, col: 10, offset: 3990},
+				pos:        position{line: 301, col: 10, offset: 8863},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1779,32 +3998,32 @@ var g = &grammar{
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 175, col: 1, offset: 4049},
+			pos:         position{line: 304, col: 1, offset: 8922},
 			expr: &choiceExpr{
-				pos: position{line: 175, col: 19, offset: 4069},
+				pos: position{line: 304, col: 19, offset: 8942},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 175, col: 19, offset: 4069},
+						pos:        position{line: 304, col: 19, offset: 8942},
 						val:        "[\\p{Z}]",
 						classes:    []*unicode.RangeTable{rangeTable("Z")},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 175, col: 29, offset: 4079},
+						pos:        position{line: 304, col: 29, offset: 8952},
 						val:        "[\\p{C}]",
 						classes:    []*unicode.RangeTable{rangeTable("C")},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&litMatcher{
-						pos:        position{line: 175, col: 39, offset: 4089},
+						pos:        position{line: 304, col: 39, offset: 8962},
 						val:        ",",
 						ignoreCase: false,
 						want:       "\",\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 175, col: 45, offset: 4095},
+						pos:  position{line: 304, col: 45, offset: 8968},
 						name: "Comment",
 					},
 				},
@@ -1812,16 +4031,16 @@ var g = &grammar{
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 177, col: 1, offset: 4115},
+			pos:  position{line: 306, col: 1, offset: 8988},
 			expr: &choiceExpr{
-				pos: position{line: 177, col: 12, offset: 4128},
+				pos: position{line: 306, col: 12, offset: 9001},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 12, offset: 4128},
+						pos:  position{line: 306, col: 12, offset: 9001},
 						name: "SingleLineComment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 32, offset: 4148},
+						pos:  position{line: 306, col: 32, offset: 9021},
 						name: "MultiLineComment",
 					},
 				},
@@ -1829,36 +4048,36 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 178, col: 1, offset: 4165},
+			pos:  position{line: 307, col: 1, offset: 9038},
 			expr: &seqExpr{
-				pos: position{line: 178, col: 21, offset: 4187},
+				pos: position{line: 307, col: 21, offset: 9060},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 178, col: 21, offset: 4187},
+						pos:        position{line: 307, col: 21, offset: 9060},
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 178, col: 26, offset: 4192},
+						pos: position{line: 307, col: 26, offset: 9065},
 						expr: &seqExpr{
-							pos: position{line: 178, col: 27, offset: 4193},
+							pos: position{line: 307, col: 27, offset: 9066},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 178, col: 27, offset: 4193},
+									pos: position{line: 307, col: 27, offset: 9066},
 									expr: &ruleRefExpr{
-										pos:  position{line: 178, col: 28, offset: 4194},
+										pos:  position{line: 307, col: 28, offset: 9067},
 										name: "EOL",
 									},
 								},
 								&anyMatcher{
-									line: 178, col: 32, offset: 4198,
+									line: 307, col: 32, offset: 9071,
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 178, col: 36, offset: 4202},
+						pos:  position{line: 307, col: 36, offset: 9075},
 						name: "EOL",
 					},
 				},
@@ -1866,38 +4085,38 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 179, col: 1, offset: 4206},
+			pos:  position{line: 308, col: 1, offset: 9079},
 			expr: &seqExpr{
-				pos: position{line: 179, col: 21, offset: 4228},
+				pos: position{line: 308, col: 21, offset: 9101},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 179, col: 21, offset: 4228},
+						pos:        position{line: 308, col: 21, offset: 9101},
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 179, col: 26, offset: 4233},
+						pos: position{line: 308, col: 26, offset: 9106},
 						expr: &seqExpr{
-							pos: position{line: 179, col: 27, offset: 4234},
+							pos: position{line: 308, col: 27, offset: 9107},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 179, col: 27, offset: 4234},
+									pos: position{line: 308, col: 27, offset: 9107},
 									expr: &litMatcher{
-										pos:        position{line: 179, col: 28, offset: 4235},
+										pos:        position{line: 308, col: 28, offset: 9108},
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&anyMatcher{
-									line: 179, col: 33, offset: 4240,
+									line: 308, col: 33, offset: 9113,
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 179, col: 37, offset: 4244},
+						pos:        position{line: 308, col: 37, offset: 9117},
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -1907,18 +4126,18 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 182, col: 1, offset: 4265},
+			pos:  position{line: 311, col: 1, offset: 9138},
 			expr: &choiceExpr{
-				pos: position{line: 182, col: 8, offset: 4274},
+				pos: position{line: 311, col: 8, offset: 9147},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 182, col: 8, offset: 4274},
+						pos:        position{line: 311, col: 8, offset: 9147},
 						val:        "\n",
 						ignoreCase: false,
 						want:       "\"\\n\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 15, offset: 4281},
+						pos:  position{line: 311, col: 15, offset: 9154},
 						name: "EOF",
 					},
 				},
@@ -1926,11 +4145,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 184, col: 1, offset: 4300},
+			pos:  position{line: 313, col: 1, offset: 9173},
 			expr: &notExpr{
-				pos: position{line: 184, col: 8, offset: 4309},
+				pos: position{line: 313, col: 8, offset: 9182},
 				expr: &anyMatcher{
-					line: 184, col: 9, offset: 4310,
+					line: 313, col: 9, offset: 9183,
 				},
 			},
 		},
@@ -1938,7 +4157,7 @@ var g = &grammar{
 }
 
 func (c *current) onAST1(expr interface{}) (interface{}, error) {
-	return expr, nil
+	return root(c, expr)
 }
 
 func (p *parser) callonAST1() (interface{}, error) {
@@ -1948,7 +4167,7 @@ func (p *parser) callonAST1() (interface{}, error) {
 }
 
 func (c *current) onNull1() (interface{}, error) {
-	return ast.Null{}, nil
+	return leaf(c, ast.Null{}), nil
 }
 
 func (p *parser) callonNull1() (interface{}, error) {
@@ -1958,7 +4177,7 @@ func (p *parser) callonNull1() (interface{}, error) {
 }
 
 func (c *current) onBoolean2() (interface{}, error) {
-	return ast.Boolean(true), nil
+	return leaf(c, ast.Boolean(true)), nil
 }
 
 func (p *parser) callonBoolean2() (interface{}, error) {
@@ -1967,51 +4186,182 @@ func (p *parser) callonBoolean2() (interface{}, error) {
 	return p.cur.onBoolean2()
 }
 
-func (c *current) onBoolean4() (interface{}, error) {
-	return ast.Boolean(false), nil
+func (c *current) onBoolean7() (interface{}, error) {
+	return leaf(c, ast.Boolean(false)), nil
+}
+
+func (p *parser) callonBoolean7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBoolean7()
+}
+
+func (c *current) onNumber2() (interface{}, error) {
+	return number(c, 0)
+}
+
+func (p *parser) callonNumber2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber2()
+}
+
+func (c *current) onNumber18() (interface{}, error) {
+	return number(c, 10)
+}
+
+func (p *parser) callonNumber18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber18()
+}
+
+func (c *current) onNumber37() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "hex digits")
+}
+
+func (p *parser) callonNumber37() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber37()
+}
+
+func (c *current) onNumber44() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "binary digits")
+}
+
+func (p *parser) callonNumber44() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber44()
+}
+
+func (c *current) onNumber51() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "octal digits")
+}
+
+func (p *parser) callonNumber51() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber51()
+}
+
+func (c *current) onNumber58() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "exponent digits")
+}
+
+func (p *parser) callonNumber58() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber58()
+}
+
+func (c *current) onNumber72() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "fraction digits")
+}
+
+func (p *parser) callonNumber72() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber72()
+}
+
+func (c *current) onNumber80() (interface{}, error) {
+	return leaf(c, ast.Null{}), malformed(c, "digits, with _ only between digits")
 }
 
-func (p *parser) callonBoolean4() (interface{}, error) {
+func (p *parser) callonNumber80() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBoolean4()
+	return p.cur.onNumber80()
 }
 
-func (c *current) onNumber1() (interface{}, error) {
-	return ast.NewNumberFromString(string(c.text))
+func (c *current) onRational1() (interface{}, error) {
+	return rational(c)
 }
 
-func (p *parser) callonNumber1() (interface{}, error) {
+func (p *parser) callonRational1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNumber1()
+	return p.cur.onRational1()
 }
 
-func (c *current) onString2() (interface{}, error) {
-	return ast.NewStringFromString(string(c.text))
+func (c *current) onString2(parts interface{}) (interface{}, error) {
+	return interpolate(c, slice(parts))
 }
 
 func (p *parser) callonString2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onString2()
+	return p.cur.onString2(stack["parts"])
+}
+
+func (c *current) onString9() (interface{}, error) {
+	return leaf(c, ast.Null{}), unterminated("string")
+}
+
+func (p *parser) callonString9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onString9()
+}
+
+func (c *current) onstrPart2(exp interface{}) (interface{}, error) {
+	return exp, nil
+}
+
+func (p *parser) callonstrPart2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onstrPart2(stack["exp"])
+}
+
+func (c *current) onstrPart12() (interface{}, error) {
+	return text(leaf(c, ast.String{Val: string(c.text)})), nil
+}
+
+func (p *parser) callonstrPart12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onstrPart12()
+}
+
+func (c *current) onKey1() (interface{}, error) {
+	str, err := ast.NewStringFromString(string(c.text))
+	return leaf(c, str), err
+}
+
+func (p *parser) callonKey1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onKey1()
 }
 
-func (c *current) onString8() (interface{}, error) {
-	return ast.Null{}, errors.New("not terminated")
+func (c *current) onRegex2() (interface{}, error) {
+	return leaf(c, ast.String{Val: regex(c.text)}), nil
 }
 
-func (p *parser) callonString8() (interface{}, error) {
+func (p *parser) callonRegex2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onString8()
+	return p.cur.onRegex2()
+}
+
+func (c *current) onRegex12() (interface{}, error) {
+	return leaf(c, ast.Null{}), unterminated("string")
+}
+
+func (p *parser) callonRegex12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegex12()
 }
 
 func (c *current) onArray2(list interface{}) (interface{}, error) {
 	if list == nil {
-		return ast.Array{}, nil
+		return leaf(c, ast.Array{}), nil
 	}
-	return list, nil
+	return wrap(c, list.(node)), nil
 }
 
 func (p *parser) callonArray2() (interface{}, error) {
@@ -2021,7 +4371,7 @@ func (p *parser) callonArray2() (interface{}, error) {
 }
 
 func (c *current) onArray9() (interface{}, error) {
-	return ast.Null{}, errors.New("not terminated")
+	return leaf(c, ast.Null{}), nil
 }
 
 func (p *parser) callonArray9() (interface{}, error) {
@@ -2030,15 +4380,25 @@ func (p *parser) callonArray9() (interface{}, error) {
 	return p.cur.onArray9()
 }
 
+func (c *current) onArray26() (interface{}, error) {
+	return leaf(c, ast.Null{}), unterminated("array")
+}
+
+func (p *parser) callonArray26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArray26()
+}
+
 func (c *current) onMat1(first, rest interface{}) (interface{}, error) {
-	mat := join(first, rest, 1)
+	rows := join(first, rest, 1)
 	if len(slice(rest)) == 0 {
-		if first == nil {
-			return ast.Array{}, nil
-		}
-		return first.(ast.Array), nil
+		return first, nil
+	}
+	if len(rows) == 0 {
+		return nil, nil
 	}
-	return ast.Array(mat), nil
+	return array(rows), nil
 }
 
 func (p *parser) callonMat1() (interface{}, error) {
@@ -2048,7 +4408,7 @@ func (p *parser) callonMat1() (interface{}, error) {
 }
 
 func (c *current) onList1(list interface{}) (interface{}, error) {
-	return ast.Array(list.([]ast.Any)), nil
+	return array(list.([]node)), nil
 }
 
 func (p *parser) callonList1() (interface{}, error) {
@@ -2057,6 +4417,16 @@ func (p *parser) callonList1() (interface{}, error) {
 	return p.cur.onList1(stack["list"])
 }
 
+func (c *current) onCall1(seq interface{}) (interface{}, error) {
+	return seq, nil
+}
+
+func (p *parser) callonCall1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCall1(stack["seq"])
+}
+
 func (c *current) onSeq1(first, rest interface{}) (interface{}, error) {
 	return join(first, rest, 1), nil
 }
@@ -2068,7 +4438,7 @@ func (p *parser) callonSeq1() (interface{}, error) {
 }
 
 func (c *current) onMap2(first, rest interface{}) (interface{}, error) {
-	return ast.Map(merge(first, rest, 0, 4)), nil
+	return merge(c, first, rest, 0, 4), nil
 }
 
 func (p *parser) callonMap2() (interface{}, error) {
@@ -2078,7 +4448,7 @@ func (p *parser) callonMap2() (interface{}, error) {
 }
 
 func (c *current) onMap32() (interface{}, error) {
-	return ast.Null{}, errors.New("not terminated")
+	return leaf(c, ast.Null{}), nil
 }
 
 func (p *parser) callonMap32() (interface{}, error) {
@@ -2087,31 +4457,152 @@ func (p *parser) callonMap32() (interface{}, error) {
 	return p.cur.onMap32()
 }
 
-func (c *current) onSymbol1() (interface{}, error) {
+func (c *current) onMap52() (interface{}, error) {
+	return leaf(c, ast.Null{}), unterminated("map")
+}
+
+func (p *parser) callonMap52() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMap52()
+}
+
+func (c *current) onPostfix1(any, suffixes interface{}) (interface{}, error) {
+	return postfix(any.(node), slice(suffixes)), nil
+}
+
+func (p *parser) callonPostfix1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPostfix1(stack["any"], stack["suffixes"])
+}
+
+func (c *current) onMember1() (interface{}, error) {
+	key := leaf(c, ast.String{Val: string(c.text[1:])})
+	return []node{operator(c, "."), key}, nil
+}
+
+func (p *parser) callonMember1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMember1()
+}
+
+func (c *current) onIndex1(key interface{}) (interface{}, error) {
+	return []node{operator(c, "[]"), unwrap(key.(node))}, nil
+}
+
+func (p *parser) callonIndex1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndex1(stack["key"])
+}
+
+func (c *current) onSlice1(start, end interface{}) (interface{}, error) {
+	return []node{operator(c, "[:]"), bound(c, start), bound(c, end)}, nil
+}
+
+func (p *parser) callonSlice1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSlice1(stack["start"], stack["end"])
+}
+
+func (c *current) onSymbol2() (interface{}, error) {
+	return symbol(c)
+}
+
+func (p *parser) callonSymbol2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSymbol2()
+}
+
+func (c *current) onSymbol13() (interface{}, error) {
+	return symbol(c)
+}
+
+func (p *parser) callonSymbol13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSymbol13()
+}
+
+func (c *current) onName1() (interface{}, error) {
 	return symbol(c)
 }
 
-func (p *parser) callonSymbol1() (interface{}, error) {
+func (p *parser) callonName1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onName1()
+}
+
+func (c *current) onLone1(name interface{}) (interface{}, error) {
+	return []node{name.(node)}, nil
+}
+
+func (p *parser) callonLone1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLone1(stack["name"])
+}
+
+func (c *current) onQuoted2(any interface{}) (interface{}, error) {
+	return quote(c, "quote", any)
+}
+
+func (p *parser) callonQuoted2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoted2(stack["any"])
+}
+
+func (c *current) onQuoted9(any interface{}) (interface{}, error) {
+	return quote(c, "quasiquote", any)
+}
+
+func (p *parser) callonQuoted9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoted9(stack["any"])
+}
+
+func (c *current) onQuoted16(any interface{}) (interface{}, error) {
+	return quote(c, "unquote-splicing", any)
+}
+
+func (p *parser) callonQuoted16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSymbol1()
+	return p.cur.onQuoted16(stack["any"])
 }
 
-func (c *current) onSExpr2(expr interface{}) (interface{}, error) {
-	if expr == nil {
-		return ast.Expr{}, nil
+func (c *current) onQuoted23(any interface{}) (interface{}, error) {
+	return quote(c, "unquote", any)
+}
+
+func (p *parser) callonQuoted23() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoted23(stack["any"])
+}
+
+func (c *current) onSExpr2(exp interface{}) (interface{}, error) {
+	if exp == nil {
+		return leaf(c, ast.Expr{}), nil
 	}
-	return expr, nil
+	return wrap(c, exp.(node)), nil
 }
 
 func (p *parser) callonSExpr2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSExpr2(stack["expr"])
+	return p.cur.onSExpr2(stack["exp"])
 }
 
 func (c *current) onSExpr9() (interface{}, error) {
-	return ast.Null{}, errors.New("not terminated")
+	return leaf(c, ast.Null{}), nil
 }
 
 func (p *parser) callonSExpr9() (interface{}, error) {
@@ -2120,14 +4611,24 @@ func (p *parser) callonSExpr9() (interface{}, error) {
 	return p.cur.onSExpr9()
 }
 
-func (c *current) onExpr1(expr interface{}) (interface{}, error) {
-	return ast.Expr(expr.([]ast.Any)), nil
+func (c *current) onSExpr26() (interface{}, error) {
+	return leaf(c, ast.Null{}), unterminated("expression")
+}
+
+func (p *parser) callonSExpr26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSExpr26()
+}
+
+func (c *current) onExpr1(exp interface{}) (interface{}, error) {
+	return sexpr(exp.([]node)), nil
 }
 
 func (p *parser) callonExpr1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpr1(stack["expr"])
+	return p.cur.onExpr1(stack["exp"])
 }
 
 func (c *current) onFnOp1() (interface{}, error) {
@@ -2170,6 +4671,46 @@ func (p *parser) callonAsExpr1() (interface{}, error) {
 	return p.cur.onAsExpr1(stack["left"], stack["right"])
 }
 
+func (c *current) onPipeOp1() (interface{}, error) {
+	return symbol(c)
+}
+
+func (p *parser) callonPipeOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPipeOp1()
+}
+
+func (c *current) onPipeExpr2(left, right interface{}) (interface{}, error) {
+	return pipe(left, right, 1, 3), nil
+}
+
+func (p *parser) callonPipeExpr2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPipeExpr2(stack["left"], stack["right"])
+}
+
+func (c *current) onCondOp1() (interface{}, error) {
+	return symbol(c)
+}
+
+func (p *parser) callonCondOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCondOp1()
+}
+
+func (c *current) onCondExpr1(left, right interface{}) (interface{}, error) {
+	return ternary(left, right, 1, 3, 8), nil
+}
+
+func (p *parser) callonCondExpr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCondExpr1(stack["left"], stack["right"])
+}
+
 func (c *current) onOrOp1() (interface{}, error) {
 	return symbol(c)
 }
@@ -2300,18 +4841,59 @@ func (p *parser) callonUnaOp1() (interface{}, error) {
 	return p.cur.onUnaOp1()
 }
 
-func (c *current) onUnary1(uop, any interface{}) (interface{}, error) {
-	if uop != nil {
-		return []ast.Any{uop.(ast.Symbol), any.(ast.Any)}, nil
+func (c *current) onUnary2(uop, operand interface{}) (interface{}, error) {
+	return []node{uop.(node), single(operand.([]node))}, nil
+}
+
+func (p *parser) callonUnary2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnary2(stack["uop"], stack["operand"])
+}
+
+func (c *current) onPowOp1() (interface{}, error) {
+	return symbol(c)
+}
+
+func (p *parser) callonPowOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowOp1()
+}
+
+func (c *current) onPowExpr1(left, right interface{}) (interface{}, error) {
+	if right == nil {
+		// no-operator
+		return []node{left.(node)}, nil
 	}
-	// no-operator
-	return []ast.Any{any.(ast.Any)}, nil
+	frag := slice(right)
+	return power(left.(node), frag[1].(node), single(frag[3].([]node))), nil
+}
+
+func (p *parser) callonPowExpr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowExpr1(stack["left"], stack["right"])
+}
+
+func (c *current) onJunk1() (interface{}, error) {
+	return nil, unexpected(c)
+}
+
+func (p *parser) callonJunk1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJunk1()
+}
+
+func (c *current) onStray1() (interface{}, error) {
+	return nil, unexpected(c)
 }
 
-func (p *parser) callonUnary1() (interface{}, error) {
+func (p *parser) callonStray1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnary1(stack["uop"], stack["any"])
+	return p.cur.onStray1()
 }
 
 var (
@@ -2372,18 +4954,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
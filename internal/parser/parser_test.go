@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+func sym(name string) ast.Symbol {
+	return ast.Symbol{Val: name}
+}
+
+// parse src as a single top-level expression, positions are ignored when comparing
+func parsesAs(t *testing.T, src string, want ast.Any) {
+	t.Helper()
+	got, err := Parse("test", []byte(src))
+	if err != nil {
+		t.Fatalf("%s: %v", src, err)
+	}
+	if exp := (ast.Expr{want}); !got.(ast.Any).Equal(exp) {
+		t.Errorf("%s: got %v, want %v", src, got, exp)
+	}
+}
+
+func TestHyphenatedSymbols(t *testing.T) {
+	minus := ast.Expr{sym("-"), sym("x"), sym("y")}
+	// an item of a sequence or map is a hyphenated symbol
+	parsesAs(t, "(re-match? r s)", ast.Expr{sym("re-match?"), sym("r"), sym("s")})
+	parsesAs(t, "(map type-of xs)", ast.Expr{sym("map"), sym("type-of"), sym("xs")})
+	parsesAs(t, "[x-y 1]", ast.Array{sym("x-y"), ast.NewNumber(1)})
+	parsesAs(t, "[x-y]", ast.Array{sym("x-y")})
+	parsesAs(t, `{"f": type-of}`, ast.Map{ast.String{Val: "f"}: sym("type-of")})
+	parsesAs(t, "(xs |> type-of)", ast.Expr{sym("type-of"), sym("xs")})
+	parsesAs(t, "'re-find-all", ast.Expr{sym("quote"), sym("re-find-all")})
+	// as an operand it is subtraction
+	parsesAs(t, "(x-y)", minus)
+	parsesAs(t, "(z := x-y)", ast.Expr{sym(":="), sym("z"), minus})
+	parsesAs(t, "(x-1)", ast.Expr{sym("-"), sym("x"), ast.NewNumber(1)})
+}
+
+func TestLeadingPlus(t *testing.T) {
+	// +digit is a signed number, like -digit
+	parsesAs(t, "+1", ast.NewNumber(1))
+	parsesAs(t, "(+1 - x)", ast.Expr{sym("-"), ast.NewNumber(1), sym("x")})
+	parsesAs(t, "(x +1)", ast.Expr{sym("x"), ast.NewNumber(1)})
+	parsesAs(t, "(x -1)", ast.Expr{sym("x"), ast.NewNumber(-1)})
+	parsesAs(t, "[1 +2]", ast.Array{ast.NewNumber(1), ast.NewNumber(2)})
+	parsesAs(t, "[0xff +0o17]", ast.Array{ast.NewNumber(255), ast.NewNumber(15)})
+	parsesAs(t, "(f 1 +2)", ast.Expr{sym("f"), ast.NewNumber(1), ast.NewNumber(2)})
+	// spaced, it is addition
+	parsesAs(t, "(x + 1)", ast.Expr{sym("+"), sym("x"), ast.NewNumber(1)})
+}
+
+func TestNegatedPower(t *testing.T) {
+	// unary minus binds looser than ** for literals and other operands alike
+	square := func(base ast.Any) ast.Expr {
+		return ast.Expr{sym("**"), base, ast.NewNumber(2)}
+	}
+	parsesAs(t, "(-2 ** 2)", ast.Expr{sym("-"), square(ast.NewNumber(2))})
+	parsesAs(t, "(-x ** 2)", ast.Expr{sym("-"), square(sym("x"))})
+	parsesAs(t, "(0 - 2 ** 2)", ast.Expr{sym("-"), ast.NewNumber(0), square(ast.NewNumber(2))})
+	parsesAs(t, "((-2) ** 2)", square(ast.Expr{ast.NewNumber(-2)}))
+}
+
+func TestPipeStages(t *testing.T) {
+	// a parenthesized call takes the threaded value as an arg
+	parsesAs(t, "(xs |> (get 0))", ast.Expr{sym("get"), sym("xs"), ast.NewNumber(0)})
+	parsesAs(t, "(xs |>> (map f))", ast.Expr{sym("map"), sym("f"), sym("xs")})
+	// a function expression is called with it
+	fn := ast.Expr{sym("=>"), ast.Array{sym("x")}, sym("x")}
+	parsesAs(t, "(4 |> ([x] => x))", ast.Expr{fn, ast.NewNumber(4)})
+	parsesAs(t, "(4 |>> ([x] => x))", ast.Expr{fn, ast.NewNumber(4)})
+	fn = ast.Expr{sym("func"), ast.Array{sym("x")}, sym("x")}
+	parsesAs(t, "(4 |> (func [x] x))", ast.Expr{fn, ast.NewNumber(4)})
+}
+
+func TestNumberLiterals(t *testing.T) {
+	tests := []struct {
+		src  string
+		want int64
+	}{
+		{"1_000_000", 1000000},
+		{"0xff", 255},
+		{"0XFF", 255},
+		{"-0x1_0", -16},
+		{"0b1010", 10},
+		{"0o17", 15},
+		{"+0o17", 15},
+	}
+	for _, test := range tests {
+		parsesAs(t, test.src, ast.NewNumber(test.want))
+	}
+	num, _ := ast.NewNumberFromString("1.5e-3")
+	parsesAs(t, "1_5e-0_4", num)
+	for _, src := range []string{"0x", "0b102", "0o8", "1__0", "1_", "1.e5", "1e", "12ab"} {
+		if _, err := Parse("test", []byte(src)); err == nil {
+			t.Errorf("%s: got no error", src)
+		}
+	}
+}
@@ -31,6 +31,7 @@ import (
 )
 
 func main() {
+	trap()
 	cmd.Execute()
 }
 
@@ -42,5 +43,8 @@ func trap() {
 		sig := <-traps
 		fmt.Fprintln(os.Stderr, "signal:", sig)
 		cmd.Quit()
+		// exit if stuck
+		<-traps
+		os.Exit(1)
 	}()
 }
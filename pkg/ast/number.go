@@ -1,6 +1,9 @@
 package ast
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -22,3 +25,30 @@ func NewNumberFromString(num string) (Number, error) {
 func (val Number) Decimal() decimal.Decimal {
 	return decimal.Decimal(val)
 }
+
+// exact rational from big.Rat, not retained
+func NewRational(rat *big.Rat) Rational {
+	return Rational{new(big.Rat).Set(rat)}
+}
+
+// parse rational from string (eg. 1/3)
+func NewRationalFromString(num string) (Rational, error) {
+	rat, ok := new(big.Rat).SetString(num)
+	if !ok {
+		return Rational{}, fmt.Errorf("can't convert %s to rational", num)
+	}
+	return Rational{rat}, nil
+}
+
+// copy of the big.Rat value
+func (val Rational) Rat() *big.Rat {
+	return new(big.Rat).Set(val.rat())
+}
+
+// big.Rat value, the zero value is 0
+func (val Rational) rat() *big.Rat {
+	if val.val == nil {
+		return new(big.Rat)
+	}
+	return val.val
+}
@@ -0,0 +1,475 @@
+package ast
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	anyType       = reflect.TypeOf((*Any)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+	decimalType   = reflect.TypeOf(decimal.Decimal{})
+	ratType       = reflect.TypeOf(big.Rat{})
+	marshalType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	unmarshalType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// go value to ast, struct fields named by `oryx:"name,omitempty"` tags
+func FromGo(v interface{}) (Any, error) {
+	return fromGo(reflect.ValueOf(v), path{})
+}
+
+// set the go value pointed to by target from ast
+func ToGo(val Any, target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("target must be a non-nil pointer, got %T", target)
+	}
+	return toGo(val, rv.Elem())
+}
+
+// ast to a go value of type T, eg. ast.As[Config](val)
+func As[T any](val Any) (T, error) {
+	var res T
+	err := ToGo(val, &res)
+	return res, err
+}
+
+// pointers, maps and slices being converted, to detect cycles
+type path map[ref]bool
+
+// identity of a pointer, map or slice
+type ref struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+func fromGo(rv reflect.Value, seen path) (Any, error) {
+	if !rv.IsValid() {
+		return Null{}, nil
+	}
+	typ := rv.Type()
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			break
+		}
+		id := ref{typ, rv.Pointer(), 0}
+		if rv.Kind() == reflect.Slice {
+			id.len = rv.Len()
+		}
+		if seen[id] {
+			return Null{}, fmt.Errorf("cannot convert go %s, encountered a cycle", typ)
+		}
+		seen[id] = true
+		defer delete(seen, id)
+	}
+	switch {
+	case typ.Implements(anyType) && (typ.Kind() != reflect.Interface && typ.Kind() != reflect.Ptr || !rv.IsNil()):
+		return rv.Interface().(Any), nil
+	case typ == timeType:
+		return String{Val: rv.Interface().(time.Time).Format(time.RFC3339Nano)}, nil
+	case typ == decimalType:
+		return Number(rv.Interface().(decimal.Decimal)), nil
+	case typ == ratType:
+		rat := rv.Interface().(big.Rat)
+		return NewRational(&rat), nil
+	case typ.Implements(marshalType) && (typ.Kind() != reflect.Ptr || !rv.IsNil()):
+		text, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return Null{}, err
+		}
+		return String{Val: string(text)}, nil
+	}
+	switch rv.Kind() {
+	default:
+		return Null{}, fmt.Errorf("cannot convert go %s", typ)
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return Null{}, nil
+		}
+		return fromGo(rv.Elem(), seen)
+	case reflect.Bool:
+		return Boolean(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewNumber(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Number(decimal.NewFromBigInt(new(big.Int).SetUint64(rv.Uint()), 0)), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return Null{}, fmt.Errorf("cannot convert go %s %v to number", typ, f)
+		}
+		if rv.Kind() == reflect.Float32 {
+			return Number(decimal.NewFromFloat32(float32(f))), nil
+		}
+		return Number(decimal.NewFromFloat(f)), nil
+	case reflect.String:
+		return String{Val: rv.String()}, nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return Null{}, nil
+		}
+		return fromGoArray(rv, seen)
+	case reflect.Array:
+		return fromGoArray(rv, seen)
+	case reflect.Map:
+		if rv.IsNil() {
+			return Null{}, nil
+		}
+		res := make(Map, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			key, err := fromGoKey(iter.Key())
+			if err != nil {
+				return Null{}, err
+			}
+			item, err := fromGo(iter.Value(), seen)
+			if err != nil {
+				return Null{}, err
+			}
+			res[String{Val: key}] = item
+		}
+		return res, nil
+	case reflect.Struct:
+		res := Map{}
+		for _, field := range fields(typ) {
+			fv, err := rv.FieldByIndexErr(field.index)
+			if err != nil {
+				// through a nil embedded pointer
+				continue
+			}
+			if field.omitEmpty && fv.IsZero() {
+				continue
+			}
+			item, err := fromGo(fv, seen)
+			if err != nil {
+				return Null{}, fmt.Errorf("field %s: %w", field.name, err)
+			}
+			res[String{Val: field.name}] = item
+		}
+		return res, nil
+	}
+}
+
+func fromGoArray(rv reflect.Value, seen path) (Any, error) {
+	res := make(Array, rv.Len())
+	for i := range res {
+		item, err := fromGo(rv.Index(i), seen)
+		if err != nil {
+			return Null{}, err
+		}
+		res[i] = item
+	}
+	return res, nil
+}
+
+// map keys are strings or text marshalers
+func fromGoKey(rv reflect.Value) (string, error) {
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	if rv.Type().Implements(marshalType) {
+		text, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	return "", fmt.Errorf("cannot convert go map key %s to string", rv.Type())
+}
+
+func toGo(val Any, rv reflect.Value) error {
+	if val == nil {
+		val = Null{}
+	}
+	typ := rv.Type()
+	switch {
+	case typ.Kind() == reflect.Interface && typ.NumMethod() == 0:
+		// plain go values for interface{}
+		res, err := plain(val)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			rv.Set(reflect.Zero(typ))
+		} else {
+			rv.Set(reflect.ValueOf(res))
+		}
+		return nil
+	case reflect.TypeOf(val).AssignableTo(typ):
+		rv.Set(reflect.ValueOf(val))
+		return nil
+	}
+	if _, ok := val.(Null); ok {
+		switch typ.Kind() {
+		default:
+			return fmt.Errorf("cannot convert null to go %s", typ)
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+			rv.Set(reflect.Zero(typ))
+			return nil
+		}
+	}
+	switch {
+	case typ == timeType:
+		str, ok := val.(String)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		t, err := time.Parse(time.RFC3339Nano, str.Val)
+		if err != nil {
+			return err
+		}
+		rv.Set(reflect.ValueOf(t))
+		return nil
+	case typ == decimalType:
+		num, ok := val.(Number)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		rv.Set(reflect.ValueOf(num.Decimal()))
+		return nil
+	case typ == ratType:
+		switch num := val.(type) {
+		default:
+			return mismatch(val, typ)
+		case Number:
+			rv.Set(reflect.ValueOf(num.Decimal().Rat()).Elem())
+		case Rational:
+			rv.Set(reflect.ValueOf(num.Rat()).Elem())
+		}
+		return nil
+	case typ.Kind() != reflect.Ptr && reflect.PtrTo(typ).Implements(unmarshalType):
+		str, ok := val.(String)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str.Val))
+	}
+	switch typ.Kind() {
+	default:
+		return fmt.Errorf("cannot convert to go %s", typ)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(typ.Elem()))
+		}
+		return toGo(val, rv.Elem())
+	case reflect.Bool:
+		b, ok := val.(Boolean)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		rv.SetBool(bool(b))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, ok := val.(Number)
+		if !ok || !num.Decimal().IsInteger() {
+			return mismatch(val, typ)
+		}
+		n := num.Decimal().BigInt()
+		if !n.IsInt64() || rv.OverflowInt(n.Int64()) {
+			return fmt.Errorf("%#v overflows go %s", val, typ)
+		}
+		rv.SetInt(n.Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		num, ok := val.(Number)
+		if !ok || !num.Decimal().IsInteger() || num.Decimal().IsNegative() {
+			return mismatch(val, typ)
+		}
+		n := num.Decimal().BigInt()
+		if !n.IsUint64() || rv.OverflowUint(n.Uint64()) {
+			return fmt.Errorf("%#v overflows go %s", val, typ)
+		}
+		rv.SetUint(n.Uint64())
+	case reflect.Float32, reflect.Float64:
+		switch num := val.(type) {
+		default:
+			return mismatch(val, typ)
+		case Number:
+			rv.SetFloat(num.Decimal().InexactFloat64())
+		case Rational:
+			f, _ := num.rat().Float64()
+			rv.SetFloat(f)
+		}
+	case reflect.String:
+		str, ok := val.(String)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		rv.SetString(str.Val)
+	case reflect.Slice:
+		arr, ok := val.(Array)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		res := reflect.MakeSlice(typ, len(arr), len(arr))
+		for i, item := range arr {
+			if err := toGo(item, res.Index(i)); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+		rv.Set(res)
+	case reflect.Array:
+		arr, ok := val.(Array)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		if len(arr) != rv.Len() {
+			return fmt.Errorf("cannot convert array of length %d to go %s", len(arr), typ)
+		}
+		for i, item := range arr {
+			if err := toGo(item, rv.Index(i)); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+	case reflect.Map:
+		m, ok := val.(Map)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		res := reflect.MakeMapWithSize(typ, len(m))
+		for _, key := range m.Keys() {
+			k := reflect.New(typ.Key()).Elem()
+			if err := toGoKey(key.Val, k); err != nil {
+				return err
+			}
+			item := reflect.New(typ.Elem()).Elem()
+			if err := toGo(m[key], item); err != nil {
+				return fmt.Errorf("key %#v: %w", key.Val, err)
+			}
+			res.SetMapIndex(k, item)
+		}
+		rv.Set(res)
+	case reflect.Struct:
+		m, ok := val.(Map)
+		if !ok {
+			return mismatch(val, typ)
+		}
+		// keys without a field are ignored
+		for _, field := range fields(typ) {
+			item, exists := m[String{Val: field.name}]
+			if !exists {
+				continue
+			}
+			if err := toGo(item, fieldByIndex(rv, field.index)); err != nil {
+				return fmt.Errorf("field %s: %w", field.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func toGoKey(key string, rv reflect.Value) error {
+	if rv.Kind() == reflect.String {
+		rv.SetString(key)
+		return nil
+	}
+	if reflect.PtrTo(rv.Type()).Implements(unmarshalType) {
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(key))
+	}
+	return fmt.Errorf("cannot convert map key to go %s", rv.Type())
+}
+
+// go value for interface{}
+func plain(val Any) (interface{}, error) {
+	switch arg := val.(type) {
+	default:
+		return val, nil
+	case Null:
+		return nil, nil
+	case Boolean:
+		return bool(arg), nil
+	case Number:
+		return arg.Decimal(), nil
+	case Rational:
+		return arg.Rat(), nil
+	case String:
+		return arg.Val, nil
+	case Array:
+		res := make([]interface{}, len(arg))
+		for i, item := range arg {
+			v, err := plain(item)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = v
+		}
+		return res, nil
+	case Map:
+		res := make(map[string]interface{}, len(arg))
+		for key, item := range arg {
+			v, err := plain(item)
+			if err != nil {
+				return nil, err
+			}
+			res[key.Val] = v
+		}
+		return res, nil
+	}
+}
+
+func mismatch(val Any, typ reflect.Type) error {
+	return fmt.Errorf("cannot convert %#v to go %s", val, typ)
+}
+
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// exported fields, with embedded structs flattened
+func fields(typ reflect.Type) []field {
+	var res []field
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		tag := sf.Tag.Get("oryx")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" {
+			embedded := sf.Type
+			if embedded.Kind() == reflect.Ptr {
+				if !sf.IsExported() {
+					// can't be allocated
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for _, inner := range fields(embedded) {
+					inner.index = append([]int{i}, inner.index...)
+					res = append(res, inner)
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		res = append(res, field{name, []int{i}, opts == "omitempty"})
+	}
+	return res
+}
+
+// field for setting, allocating embedded pointers
+func fieldByIndex(rv reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv
+}
@@ -0,0 +1,163 @@
+package ast
+
+import (
+	"math"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type point struct {
+	X, Y int
+}
+
+type config struct {
+	Name    string         `oryx:"name"`
+	Port    uint16         `oryx:"port,omitempty"`
+	Tags    []string       `oryx:"tags,omitempty"`
+	Skip    bool           `oryx:"-"`
+	Limits  map[string]int `oryx:"limits,omitempty"`
+	Started time.Time      `oryx:"started,omitempty"`
+	Ratio   big.Rat        `oryx:"ratio,omitempty"`
+	Host    net.IP         `oryx:"host,omitempty"`
+	Extra   map[string]Any `oryx:"extra,omitempty"`
+	secret  string
+	point
+}
+
+type node struct {
+	Next *node
+}
+
+func TestFromGo(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{nil, `null`},
+		{true, `true`},
+		{-3, `-3`},
+		{uint64(math.MaxUint64), `18446744073709551615`},
+		{1.5, `1.5`},
+		{float32(0.1), `0.1`},
+		{"a", `"a"`},
+		{[]int{1, 2}, `[1 2]`},
+		{[2]bool{true}, `[true false]`},
+		{[]int(nil), `null`},
+		{(*int)(nil), `null`},
+		{map[string]int{"a": 1}, `{"a":1}`},
+		{decimal.RequireFromString("0.10"), `0.1`},
+		{*big.NewRat(1, 3), `1/3r`},
+		{started, `"2024-01-02T03:04:05Z"`},
+		{net.ParseIP("10.0.0.1"), `"10.0.0.1"`},
+		{String{Val: "x"}, `"x"`},
+		{point{1, 2}, `{"X":1 "Y":2}`},
+		{config{Name: "a", Skip: true, secret: "s"}, `{"X":0 "Y":0 "name":"a"}`},
+		{config{Name: "a", Port: 80, Tags: []string{"x"}, Started: started}, `{"X":0 "Y":0 "name":"a" "port":80 "started":"2024-01-02T03:04:05Z" "tags":["x"]}`},
+		{config{point: point{1, 2}}, `{"X":1 "Y":2 "name":""}`},
+	}
+	for _, test := range tests {
+		got, err := FromGo(test.in)
+		if err != nil {
+			t.Errorf("%#v: %v", test.in, err)
+			continue
+		}
+		if got.GoString() != test.want {
+			t.Errorf("%#v: got %s, want %s", test.in, got.GoString(), test.want)
+		}
+	}
+}
+
+func TestFromGoErrors(t *testing.T) {
+	loop := &node{}
+	loop.Next = loop
+	self := []interface{}{nil}
+	self[0] = self
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{loop, `cannot convert go *ast.node, encountered a cycle`},
+		{self, `encountered a cycle`},
+		{math.NaN(), `cannot convert go float64 NaN to number`},
+		{make(chan int), `cannot convert go chan int`},
+		{map[int]int{1: 1}, `cannot convert go map key int to string`},
+		{struct{ F func() }{}, `field F: cannot convert go func()`},
+	}
+	for _, test := range tests {
+		_, err := FromGo(test.in)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%T: got error %v, want %q", test.in, err, test.want)
+		}
+	}
+	// shared but acyclic is not a cycle
+	shared := &point{1, 2}
+	if _, err := FromGo([]*point{shared, shared}); err != nil {
+		t.Errorf("shared pointer: %v", err)
+	}
+}
+
+func TestToGo(t *testing.T) {
+	val := Map{
+		String{Val: "name"}:    String{Val: "a"},
+		String{Val: "port"}:    NewNumber(80),
+		String{Val: "tags"}:    Array{String{Val: "x"}, String{Val: "y"}},
+		String{Val: "limits"}:  Map{String{Val: "n"}: NewNumber(3)},
+		String{Val: "started"}: String{Val: "2024-01-02T03:04:05Z"},
+		String{Val: "ratio"}:   NewRational(big.NewRat(1, 3)),
+		String{Val: "host"}:    String{Val: "10.0.0.1"},
+		String{Val: "extra"}:   Map{String{Val: "k"}: Boolean(true)},
+		String{Val: "X"}:       NewNumber(7),
+		String{Val: "unknown"}: Null{},
+	}
+	cfg, err := As[config](val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Name != "a" || cfg.Port != 80 || strings.Join(cfg.Tags, ",") != "x,y" || cfg.Limits["n"] != 3 {
+		t.Errorf("got %+v", cfg)
+	}
+	if !cfg.Started.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) || cfg.Ratio.String() != "1/3" || cfg.Host.String() != "10.0.0.1" {
+		t.Errorf("got %+v", cfg)
+	}
+	if cfg.Extra["k"] != Boolean(true) || cfg.X != 7 {
+		t.Errorf("got %+v", cfg)
+	}
+	var plain interface{}
+	if err := ToGo(Array{Null{}, NewNumber(1), String{Val: "s"}}, &plain); err != nil {
+		t.Fatal(err)
+	}
+	if arr, ok := plain.([]interface{}); !ok || len(arr) != 3 || arr[0] != nil || !arr[1].(decimal.Decimal).Equal(decimal.NewFromInt(1)) || arr[2] != "s" {
+		t.Errorf("got %#v", plain)
+	}
+}
+
+func TestToGoErrors(t *testing.T) {
+	tests := []struct {
+		val    Any
+		target interface{}
+		want   string
+	}{
+		{NewNumber(1), 1, `target must be a non-nil pointer, got int`},
+		{Null{}, new(int), `cannot convert null to go int`},
+		{NewNumber(300), new(uint8), `300 overflows go uint8`},
+		{NewNumber(-1), new(uint), `cannot convert -1 to go uint`},
+		{NewNumber(1), new(string), `cannot convert 1 to go string`},
+		{Array{NewNumber(1)}, new([2]int), `cannot convert array of length 1 to go [2]int`},
+		{Array{String{Val: "a"}}, new([]int), `index 0: cannot convert "a" to go int`},
+		{Map{String{Val: "port"}: NewNumber(-1)}, new(config), `field port: cannot convert -1 to go uint16`},
+		{Map{String{Val: "a"}: NewNumber(1)}, new(map[int]int), `cannot convert map key to go int`},
+		{String{Val: "x"}, new(time.Time), `cannot parse "x"`},
+	}
+	for _, test := range tests {
+		err := ToGo(test.val, test.target)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%#v to %T: got error %v, want %q", test.val, test.target, err, test.want)
+		}
+	}
+}
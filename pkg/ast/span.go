@@ -0,0 +1,130 @@
+package ast
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// source span of a parsed node, with the spans of its children
+type Span struct {
+	Start, End Position
+	// items of an Array or Expr, by index
+	Items []*Span
+	// values of a Map, by key
+	Entries map[String]*Span
+}
+
+// side table of spans, keyed by node identity, safe for concurrent use
+//
+// scalar nodes have no identity, so their spans are found
+// through the Items or Entries of their parent
+type Spans struct {
+	mu    sync.RWMutex
+	nodes map[interface{}]*Span
+}
+
+func NewSpans() *Spans {
+	return &Spans{nodes: make(map[interface{}]*Span)}
+}
+
+// identity of an Array, Expr or Map node, a slice sharing all of
+// ptr, len and cap views the same items as the node
+type identity struct {
+	kind byte
+	ptr  unsafe.Pointer
+	len  int
+	cap  int
+}
+
+func identify(node Any) (key identity, ok bool) {
+	switch val := node.(type) {
+	default:
+		return
+	case Array:
+		if len(val) == 0 {
+			return
+		}
+		return identity{'a', unsafe.Pointer(&val[0]), len(val), cap(val)}, true
+	case Expr:
+		if len(val) == 0 {
+			return
+		}
+		return identity{'e', unsafe.Pointer(&val[0]), len(val), cap(val)}, true
+	case Map:
+		if val == nil {
+			return
+		}
+		return identity{'m', unsafe.Pointer(reflect.ValueOf(val).Pointer()), 0, 0}, true
+	}
+}
+
+// record the span of node and its descendants
+func (spans *Spans) Index(node Any, span *Span) {
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+	spans.index(node, span)
+}
+
+func (spans *Spans) index(node Any, span *Span) {
+	if span == nil {
+		return
+	}
+	if key, ok := identify(node); ok {
+		spans.nodes[key] = span
+	}
+	switch val := node.(type) {
+	case Array:
+		spans.indexItems(val, span)
+	case Expr:
+		spans.indexItems(val, span)
+	case Map:
+		for key, item := range val {
+			spans.index(item, span.Entries[key])
+		}
+	}
+}
+
+func (spans *Spans) indexItems(items []Any, span *Span) {
+	for i, item := range items {
+		if i < len(span.Items) {
+			spans.index(item, span.Items[i])
+		}
+	}
+}
+
+// add the spans of another table
+func (spans *Spans) Merge(other *Spans) {
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+	for key, span := range other.nodes {
+		spans.nodes[key] = span
+	}
+}
+
+// remove the spans of another table, so its nodes can be collected
+func (spans *Spans) Drop(other *Spans) {
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+	for key := range other.nodes {
+		delete(spans.nodes, key)
+	}
+}
+
+// span of an Array, Expr or Map node, or nil if unknown
+func (spans *Spans) Of(node Any) *Span {
+	if spans == nil {
+		return nil
+	}
+	key, ok := identify(node)
+	if !ok {
+		return nil
+	}
+	spans.mu.RLock()
+	defer spans.mu.RUnlock()
+	return spans.nodes[key]
+}
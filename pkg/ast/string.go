@@ -7,7 +7,20 @@ import (
 
 // parse quoted string with escape-sequences
 func NewStringFromString(val string) (String, error) {
-	str := strings.Replace(val, "\\/", "/", -1)
-	str, err := strconv.Unquote(str)
+	// \/ and \$ are not supported by strconv
+	var b strings.Builder
+	for i := 0; i < len(val); i++ {
+		if val[i] == '\\' && i+1 < len(val) {
+			switch val[i+1] {
+			case '/', '$':
+				i++
+			default:
+				b.WriteByte(val[i])
+				i++
+			}
+		}
+		b.WriteByte(val[i])
+	}
+	str, err := strconv.Unquote(b.String())
 	return String{Val: str}, err
 }
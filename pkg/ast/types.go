@@ -2,6 +2,8 @@ package ast
 
 import (
 	"fmt"
+	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -74,6 +76,32 @@ func (val Number) Equal(arg Any) bool {
 		return false
 	case Number:
 		return val.Decimal().Equal(num.Decimal())
+	case Rational:
+		return val.Decimal().Rat().Cmp(num.Rat()) == 0
+	}
+}
+
+// type:rational
+type Rational struct {
+	val *big.Rat
+}
+
+func (val Rational) String() string {
+	return val.GoString()
+}
+
+func (val Rational) GoString() string {
+	return val.rat().String() + "r"
+}
+
+func (val Rational) Equal(arg Any) bool {
+	switch num := arg.(type) {
+	default:
+		return false
+	case Rational:
+		return val.rat().Cmp(num.rat()) == 0
+	case Number:
+		return num.Equal(val)
 	}
 }
 
@@ -139,15 +167,26 @@ func (val Map) String() string {
 }
 
 func (val Map) GoString() string {
-	i := 0
-	res := make([]string, len(val))
-	for key, item := range val {
-		res[i] = key.GoString() + ":" + item.GoString()
-		i++
+	keys := val.Keys()
+	res := make([]string, len(keys))
+	for i, key := range keys {
+		res[i] = key.GoString() + ":" + val[key].GoString()
 	}
 	return "{" + strings.Join(res, " ") + "}"
 }
 
+// keys in sorted order
+func (val Map) Keys() []String {
+	keys := make([]String, 0, len(val))
+	for key := range val {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Val < keys[j].Val
+	})
+	return keys
+}
+
 func (val Map) Equal(arg Any) bool {
 	switch val2 := arg.(type) {
 	default:
@@ -226,3 +265,31 @@ func (val Expr) Equal(arg Any) bool {
 		return true
 	}
 }
+
+// type:error
+type Error struct {
+	Message string
+	Kind    string
+	Data    Map
+	Pos     *Position
+}
+
+func (val Error) String() string {
+	return val.GoString()
+}
+
+func (val Error) GoString() string {
+	if len(val.Data) > 0 {
+		return fmt.Sprintf("%s(%#v %#v)", val.Kind, val.Message, val.Data)
+	}
+	return fmt.Sprintf("%s(%#v)", val.Kind, val.Message)
+}
+
+func (val Error) Equal(arg Any) bool {
+	switch err := arg.(type) {
+	default:
+		return false
+	case Error:
+		return val.Kind == err.Kind && val.Message == err.Message && val.Data.Equal(err.Data)
+	}
+}
@@ -0,0 +1,122 @@
+package eval
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+var (
+	envType   = reflect.TypeOf((*Env)(nil))
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// define a go function in the environment, eg. env.Define("hypot", math.Hypot)
+func (env *Env) Define(name string, fn interface{}) error {
+	res, err := GoFunc(name, fn)
+	if err != nil {
+		return err
+	}
+	env.Set(ast.Symbol{Val: name, Pos: nil}, res)
+	return nil
+}
+
+// function calling fn with args converted by ast.ToGo, and its result by ast.FromGo
+//
+// an *Env first param gets the calling environment, a trailing error result or a panic is raised
+func GoFunc(name string, fn interface{}) (Func, error) {
+	rv := reflect.ValueOf(fn)
+	if rv.Kind() != reflect.Func || rv.IsNil() {
+		return Func{}, fmt.Errorf("%s: wanted a go function, got %T", name, fn)
+	}
+	typ := rv.Type()
+	first := 0
+	if typ.NumIn() > 0 && typ.In(0) == envType {
+		first = 1
+	}
+	switch typ.NumOut() {
+	case 0, 1:
+		break
+	case 2:
+		if typ.Out(1) != errorType {
+			return Func{}, fmt.Errorf("%s: wanted (T, error) results, got %s", name, typ)
+		}
+	default:
+		return Func{}, fmt.Errorf("%s: wanted at most 2 results, got %s", name, typ)
+	}
+	params := typ.NumIn() - first
+	call := func(exp ast.Expr, env *Env) (ast.Any, error) {
+		args := exp[1:]
+		if typ.IsVariadic() && len(args) < params-1 {
+			return ast.Null{}, fmt.Errorf("%v: wanted at least %d arg(s), got %d", exp[0], params-1, len(args))
+		}
+		if !typ.IsVariadic() && len(args) != params {
+			return ast.Null{}, fmt.Errorf("%v: wanted %d arg(s), got %d", exp[0], params, len(args))
+		}
+		in := make([]reflect.Value, 0, first+len(args))
+		if first > 0 {
+			in = append(in, reflect.ValueOf(env))
+		}
+		for i, arg := range args {
+			param := first + i
+			var ptyp reflect.Type
+			if typ.IsVariadic() && param >= typ.NumIn()-1 {
+				ptyp = typ.In(typ.NumIn() - 1).Elem()
+			} else {
+				ptyp = typ.In(param)
+			}
+			val, err := Eval(arg, env)
+			if err != nil {
+				return ast.Null{}, err
+			}
+			ptr := reflect.New(ptyp)
+			if err := ast.ToGo(val, ptr.Interface()); err != nil {
+				return ast.Null{}, &Error{Err: fmt.Errorf("%v: arg %d: %w", exp[0], i+1, err), Pos: argPos(exp, i+1, env)}
+			}
+			in = append(in, ptr.Elem())
+		}
+		out, err := invoke(rv, in)
+		if err != nil {
+			return ast.Null{}, errorAt(fmt.Errorf("%v: %w", exp[0], err), exp, env)
+		}
+		if n := len(out); n > 0 && typ.Out(n-1) == errorType {
+			if err, _ := out[n-1].Interface().(error); err != nil {
+				return ast.Null{}, err
+			}
+			out = out[:n-1]
+		}
+		if len(out) == 0 {
+			return ast.Null{}, nil
+		}
+		return ast.FromGo(out[0].Interface())
+	}
+	return Func{Fn: call, Name: name}, nil
+}
+
+// call fn, recovering a panic as an error
+func invoke(fn reflect.Value, in []reflect.Value) (out []reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn.Call(in), nil
+}
+
+// position of exp[i], from its symbol or source span, else of the callee
+func argPos(exp ast.Expr, i int, env *Env) *ast.Position {
+	if sym, ok := exp[i].(ast.Symbol); ok && sym.Pos != nil {
+		return sym.Pos
+	}
+	if span := env.Span(exp[i]); span != nil {
+		return &span.Start
+	}
+	if span := env.Span(exp); span != nil && i < len(span.Items) && span.Items[i] != nil {
+		return &span.Items[i].Start
+	}
+	if sym, ok := exp[0].(ast.Symbol); ok {
+		return sym.Pos
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+func TestDefine(t *testing.T) {
+	env := NewEnv(nil)
+	fns := map[string]interface{}{
+		"hypot": func(x, y float64) float64 { return x*x + y*y },
+		"join":  func(sep string, parts ...string) string { return strings.Join(parts, sep) },
+		"idiv": func(x, y int) (int, error) {
+			if y == 0 {
+				return 0, errors.New("division by zero")
+			}
+			return x / y, nil
+		},
+		"prec":  func(env *Env, x int) int { return int(env.Numeric().Precision) + x },
+		"noop":  func() {},
+		"pair":  func(m map[string]int) []int { return []int{m["a"], m["b"]} },
+		"check": func(ok bool) error { return nil },
+	}
+	for name, fn := range fns {
+		if err := env.Define(name, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		src, want string
+	}{
+		{`(hypot 3 4)`, `25`},
+		{`(join "-")`, `""`},
+		{`(join "-" "a" "b" "c")`, `"a-b-c"`},
+		{`(idiv 7 2)`, `3`},
+		{`(prec 1)`, fmt.Sprint(NewEnv(nil).Numeric().Precision + 1)},
+		{`(noop)`, `null`},
+		{`(pair {"a": 1 "b": 2})`, `[1 2]`},
+		{`(check true)`, `null`},
+	}
+	for _, test := range tests {
+		val, err := EvalBytes([]byte(test.src), env)
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		if got := val.(ast.Array)[0].GoString(); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.src, got, test.want)
+		}
+	}
+}
+
+func TestDefineErrors(t *testing.T) {
+	env := NewEnv(nil)
+	env.Define("idiv", func(x, y int) (int, error) {
+		if y == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return x / y, nil
+	})
+	env.Define("div", func(x, y int) int { return x / y })
+	env.Define("join", func(sep string, parts ...string) string { return strings.Join(parts, sep) })
+	tests := []struct {
+		src, want string
+	}{
+		{`(div 1)`, `div: wanted 2 arg(s), got 1`},
+		{`(join)`, `join: wanted at least 1 arg(s), got 0`},
+		// positioned at the arg
+		{`(div 1 "a")`, `1:8: div: arg 2: cannot convert "a" to go int`},
+		{`(join "," "a" 1.5)`, `1:15: join: arg 3: cannot convert 1.5 to go string`},
+		{`(idiv 1 0)`, `division by zero`},
+		// a panic is raised at the callee
+		{`(div 1 0)`, `1:2: div: panic: runtime error: integer divide by zero`},
+	}
+	for _, test := range tests {
+		_, err := EvalBytes([]byte(test.src), env)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: got error %v, want %q", test.src, err, test.want)
+		}
+	}
+	_, err := EvalBytes([]byte(`(div 1 0)`), env)
+	var trace *Error
+	if !errors.As(err, &trace) || trace.Pos == nil {
+		t.Errorf("got %#v, want a positioned *Error", err)
+	}
+}
+
+func TestGoFuncInvalid(t *testing.T) {
+	tests := []struct {
+		fn   interface{}
+		want string
+	}{
+		{42, `f: wanted a go function, got int`},
+		{(func())(nil), `f: wanted a go function, got func()`},
+		{func() (int, int) { return 0, 0 }, `f: wanted (T, error) results, got func() (int, int)`},
+		{func() (int, int, error) { return 0, 0, nil }, `f: wanted at most 2 results`},
+	}
+	for _, test := range tests {
+		_, err := GoFunc("f", test.fn)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%T: got error %v, want %q", test.fn, err, test.want)
+		}
+	}
+}
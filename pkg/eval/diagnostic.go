@@ -0,0 +1,82 @@
+package eval
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/arizonahanson/oryx/internal/parser"
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+// syntax error with its position and source line
+type Diagnostic struct {
+	Filename string
+	Pos      ast.Position
+	Message  string
+	Line     string
+}
+
+func (diag Diagnostic) Error() string {
+	if diag.Filename != "" {
+		return fmt.Sprintf("%s:%d:%d: %s", diag.Filename, diag.Pos.Row, diag.Pos.Column, diag.Message)
+	}
+	return fmt.Sprintf("%d:%d: %s", diag.Pos.Row, diag.Pos.Column, diag.Message)
+}
+
+// source line with a caret under the position
+func (diag Diagnostic) Snippet() string {
+	caret := []rune{}
+	for i, r := range []rune(diag.Line) {
+		if int64(i) >= diag.Pos.Column-1 {
+			break
+		}
+		// keep tabs, so the caret lines up
+		if r == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+	return diag.Line + "\n" + string(caret) + "^"
+}
+
+// all syntax errors of a parse
+type Diagnostics []Diagnostic
+
+func (diags Diagnostics) Error() string {
+	res := make([]string, len(diags))
+	for i, diag := range diags {
+		res[i] = diag.Error()
+	}
+	return strings.Join(res, "\n")
+}
+
+// render each error with its snippet
+func (diags Diagnostics) Render() string {
+	var res strings.Builder
+	for _, diag := range diags {
+		fmt.Fprintf(&res, "%s\n", diag.Error())
+		for _, line := range strings.Split(diag.Snippet(), "\n") {
+			fmt.Fprintf(&res, "  %s\n", line)
+		}
+	}
+	return res.String()
+}
+
+// diagnostics for a parser error, or the error itself
+func diagnose(err error, filename string, in []byte) error {
+	fails := parser.Failures(err)
+	if len(fails) == 0 {
+		return err
+	}
+	lines := bytes.Split(in, []byte("\n"))
+	diags := make(Diagnostics, len(fails))
+	for i, fail := range fails {
+		diags[i] = Diagnostic{Filename: filename, Pos: fail.Pos, Message: fail.Message}
+		if row := fail.Pos.Row - 1; row >= 0 && row < int64(len(lines)) {
+			diags[i].Line = strings.TrimRight(string(lines[row]), "\r")
+		}
+	}
+	return diags
+}
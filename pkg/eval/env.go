@@ -1,6 +1,7 @@
 package eval
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/arizonahanson/oryx/pkg/ast"
@@ -10,10 +11,59 @@ import (
 type Env struct {
 	parent *Env
 	data   map[string]ast.Any
+	// source spans of running evaluations, shared with the outermost environment
+	spans *ast.Spans
+	// numeric context, inherited from outer environments when nil
+	numeric *Numeric
+	// cancellation, inherited from outer environments when nil
+	ctx context.Context
 }
 
 func NewEnv(outer *Env) *Env {
-	return &Env{outer, make(map[string]ast.Any)}
+	if outer == nil {
+		return &Env{parent: nil, data: make(map[string]ast.Any), spans: ast.NewSpans()}
+	}
+	return &Env{parent: outer, data: make(map[string]ast.Any), spans: outer.spans}
+}
+
+// numeric context of the nearest environment that has one
+func (env *Env) Numeric() Numeric {
+	for scope := env; scope != nil; scope = scope.parent {
+		if scope.numeric != nil {
+			return *scope.numeric
+		}
+	}
+	return DefaultNumeric
+}
+
+// set the numeric context for this environment and its children
+func (env *Env) SetNumeric(num Numeric) {
+	env.numeric = &num
+}
+
+// context of the nearest environment that has one
+func (env *Env) Context() context.Context {
+	for scope := env; scope != nil; scope = scope.parent {
+		if scope.ctx != nil {
+			return scope.ctx
+		}
+	}
+	return context.Background()
+}
+
+// set the context for this environment and its children, so evaluation stops when it is done
+func (env *Env) SetContext(ctx context.Context) {
+	env.ctx = ctx
+}
+
+// ErrCancelled if the context is done
+func (env *Env) Err() error {
+	return cancelled(env.Context())
+}
+
+// source span of a parsed Array, Expr or Map, or nil if unknown
+func (env *Env) Span(node ast.Any) *ast.Span {
+	return env.spans.Of(node)
 }
 
 // find an environment and value using a symbol
@@ -36,7 +86,7 @@ func (env *Env) find(symbol ast.Symbol) (*Env, ast.Any) {
 func (env *Env) Get(symbol ast.Symbol) (val ast.Any, err error) {
 	scope, val := env.find(symbol)
 	if scope == nil {
-		err = fmt.Errorf("%#v: not found", symbol)
+		err = &Error{Err: fmt.Errorf("%s: not found", symbol.Val), Pos: symbol.Pos}
 	}
 	return
 }
@@ -50,7 +100,7 @@ func (env *Env) Set(symbol ast.Symbol, any ast.Any) (val ast.Any) {
 	case Future:
 		// memoize future resolution
 		val = Future(func() (res ast.Any, err error) {
-			res, err = future.Get()
+			res, err = future.Await(env.Context())
 			env.Set(symbol, res)
 			return
 		})
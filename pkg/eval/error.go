@@ -0,0 +1,137 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+// error raised by throw, carrying any value
+type Thrown struct {
+	Val ast.Any
+}
+
+func (err Thrown) Error() string {
+	if val, ok := err.Val.(ast.Error); ok {
+		return val.Kind + ": " + val.Message
+	}
+	return "thrown " + err.Val.GoString()
+}
+
+// value of a go error, as seen by catch
+func ErrorValue(err error) ast.Any {
+	var thrown Thrown
+	if errors.As(err, &thrown) {
+		return thrown.Val
+	}
+	res := ast.Error{Message: err.Error(), Kind: "error", Data: ast.Map{}}
+	var trace *Error
+	if errors.As(err, &trace) {
+		res.Message = trace.Err.Error()
+		res.Pos = trace.Pos
+	}
+	return res
+}
+
+// runtime error with the position of the failing expression
+// and the oryx call stack, innermost first
+type Error struct {
+	Err    error
+	Pos    *ast.Position
+	Frames []Frame
+}
+
+// call frame of a user function
+type Frame struct {
+	Name string
+	Pos  *ast.Position
+}
+
+func (err *Error) Error() string {
+	if err.Pos != nil {
+		return fmt.Sprintf("%d:%d: %v", err.Pos.Row, err.Pos.Column, err.Err)
+	}
+	return err.Err.Error()
+}
+
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+// render as a traceback, positions prefixed with filename
+func (err *Error) Trace(filename string) string {
+	var res strings.Builder
+	fmt.Fprintf(&res, "error: %v\n", err.Err)
+	if err.Pos != nil {
+		fmt.Fprintf(&res, "  at %s\n", location(filename, err.Pos))
+	}
+	lines := 0
+	for i := 0; i < len(err.Frames); i++ {
+		if lines == maxTrace {
+			fmt.Fprintf(&res, "  … %d more frame(s)\n", len(err.Frames)-i)
+			break
+		}
+		frame := err.Frames[i]
+		fmt.Fprintf(&res, "  in %s, called at %s\n", frame.Name, location(filename, frame.Pos))
+		lines++
+		// collapse repeats of the same call, eg. from recursion
+		n := 0
+		for i+1 < len(err.Frames) && sameFrame(err.Frames[i+1], frame) {
+			i++
+			n++
+		}
+		if n > 0 {
+			fmt.Fprintf(&res, "  … %d more in %s\n", n, frame.Name)
+			lines++
+		}
+	}
+	return res.String()
+}
+
+// frames printed by Trace, before the rest are counted
+const maxTrace = 50
+
+func sameFrame(a, b Frame) bool {
+	if a.Name != b.Name || (a.Pos == nil) != (b.Pos == nil) {
+		return false
+	}
+	return a.Pos == nil || *a.Pos == *b.Pos
+}
+
+func location(filename string, pos *ast.Position) string {
+	if pos == nil {
+		return filename + ":?"
+	}
+	return fmt.Sprintf("%s:%d:%d", filename, pos.Row, pos.Column)
+}
+
+// position an error at exp, unless it already has one
+func errorAt(err error, exp ast.Expr, env *Env) error {
+	var trace *Error
+	if errors.As(err, &trace) {
+		return err
+	}
+	res := &Error{Err: err}
+	if len(exp) > 0 {
+		if sym, ok := exp[0].(ast.Symbol); ok {
+			res.Pos = sym.Pos
+		}
+	}
+	if span := env.Span(exp); res.Pos == nil && span != nil {
+		res.Pos = &span.Start
+	}
+	return res
+}
+
+// add a call frame to an error as it unwinds
+func (frame Frame) wrap(err error) error {
+	var trace *Error
+	if !errors.As(err, &trace) {
+		trace = &Error{Err: err}
+		err = trace
+	}
+	trace.Frames = append(trace.Frames, frame)
+	return err
+}
@@ -5,24 +5,43 @@ import (
 )
 
 func EvalBytes(bytes []byte, env *Env) (ast.Any, error) {
-	arg, err := Parse(bytes)
+	spans := ast.NewSpans()
+	arg, err := ParseSpans(bytes, spans)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	return Eval(arg, env)
+	return evalSpans(arg, spans, env)
 }
 
 func EvalFile(filename string, env *Env) (ast.Any, error) {
-	arg, err := ParseFile(filename)
+	spans := ast.NewSpans()
+	arg, err := ParseFileSpans(filename, spans)
 	if err != nil {
 		return ast.Null{}, err
 	}
+	return evalSpans(arg, spans, env)
+}
+
+// eval with the spans of its parse, dropped when the evaluation finishes
+func evalSpans(arg ast.Any, spans *ast.Spans, env *Env) (ast.Any, error) {
+	env.spans.Merge(spans)
+	defer env.spans.Drop(spans)
 	return Eval(arg, env)
 }
 
 // eager evaluation
 func Eval(any ast.Any, env *Env) (ast.Any, error) {
-	return FutureEval(any, env).Get()
+	return FutureEval(any, env).Await(env.Context())
+}
+
+// eager evaluation into a go value, eg. eval.EvalAs[Config](exp, env)
+func EvalAs[T any](any ast.Any, env *Env) (T, error) {
+	val, err := Eval(any, env)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return ast.As[T](val)
 }
 
 // lazy evaluation
@@ -32,6 +51,13 @@ func FutureEval(any ast.Any, env *Env) Future {
 	}
 }
 
+// future of an evaluated value, so it is not evaluated again
+func Value(val ast.Any) Future {
+	return func() (ast.Any, error) {
+		return val, nil
+	}
+}
+
 func eval(any ast.Any, env *Env) (val ast.Any, err error) {
 	val = any
 	switch arg := val.(type) {
@@ -53,8 +79,8 @@ func eval(any ast.Any, env *Env) (val ast.Any, err error) {
 	case ast.Map:
 		// map
 		res := make(ast.Map, len(arg))
-		for key, item := range arg {
-			res[key], err = Eval(item, env)
+		for _, key := range arg.Keys() {
+			res[key], err = Eval(arg[key], env)
 			if err != nil {
 				return
 			}
@@ -4,6 +4,20 @@ import "github.com/arizonahanson/oryx/pkg/ast"
 
 func (fn Func) Future(exp ast.Expr, env *Env) Future {
 	return func() (ast.Any, error) {
-		return fn.Fn(exp, env)
+		val, err := fn.Fn(exp, env)
+		if err != nil {
+			return val, errorAt(err, exp, env)
+		}
+		return val, nil
 	}
 }
+
+// call with evaluated args
+func (fn Func) Apply(args []ast.Any, env *Env) (ast.Any, error) {
+	exp := make(ast.Expr, len(args)+1)
+	exp[0] = fn
+	for i, arg := range args {
+		exp[i+1] = Value(arg)
+	}
+	return fn.Future(exp, env).Await(env.Context())
+}
@@ -1,21 +1,58 @@
 package eval
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/arizonahanson/oryx/pkg/ast"
 )
 
+// raised when the context of an evaluation is done
+var ErrCancelled = errors.New("cancelled")
+
+func cancelled(ctx context.Context) error {
+	switch ctx.Err() {
+	case nil:
+		return nil
+	case context.DeadlineExceeded:
+		return fmt.Errorf("%w: deadline exceeded", ErrCancelled)
+	default:
+		return ErrCancelled
+	}
+}
+
 // trampoline to resolve futures
 func (future Future) Get() (val ast.Any, err error) {
-	val, err = future()
+	return future.Await(context.Background())
+}
+
+// trampoline to resolve futures, until the context is done
+func (future Future) Await(ctx context.Context) (val ast.Any, err error) {
+	// tail calls replace the current frame
+	var frame *Frame
+	if err = cancelled(ctx); err == nil {
+		val, err = future()
+	}
 	for {
 		if err != nil {
+			if frame != nil {
+				err = frame.wrap(err)
+			}
 			return
 		}
 		switch future := val.(type) {
 		default:
 			return
 		case Future:
-			val, err = future()
+			if err = cancelled(ctx); err == nil {
+				val, err = future()
+			}
+		case Call:
+			frame = &future.Frame
+			if err = cancelled(ctx); err == nil {
+				val, err = future.Future()
+			}
 		}
 	}
 }
@@ -0,0 +1,62 @@
+package eval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+// future that never resolves to a value
+func endless() Future {
+	var next Future
+	next = func() (ast.Any, error) {
+		return next, nil
+	}
+	return next
+}
+
+func TestAwait(t *testing.T) {
+	val, err := Value(ast.NewNumber(1)).Await(context.Background())
+	if err != nil || !val.Equal(ast.NewNumber(1)) {
+		t.Errorf("got %v, %v, want 1", val, err)
+	}
+	done, cancel := context.WithCancel(context.Background())
+	cancel()
+	deadline, stop := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer stop()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"cancelled", done, "cancelled"},
+		{"deadline", deadline, "cancelled: deadline exceeded"},
+	}
+	for _, test := range tests {
+		_, err := endless().Await(test.ctx)
+		if !errors.Is(err, ErrCancelled) || err.Error() != test.want {
+			t.Errorf("%s: got error %v, want %q", test.name, err, test.want)
+		}
+	}
+}
+
+func TestEnvContext(t *testing.T) {
+	env := NewEnv(nil)
+	if env.Err() != nil {
+		t.Errorf("got %v, want no error", env.Err())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	env.SetContext(ctx)
+	inner := NewEnv(env)
+	cancel()
+	if !errors.Is(inner.Err(), ErrCancelled) {
+		t.Errorf("got %v, want the outer context", inner.Err())
+	}
+	_, err := EvalBytes([]byte(`[1 2 3]`), inner)
+	if !errors.Is(err, ErrCancelled) {
+		t.Errorf("got %v, want %v", err, ErrCancelled)
+	}
+}
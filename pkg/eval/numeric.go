@@ -0,0 +1,142 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// rounding mode for inexact results
+type Rounding int
+
+const (
+	// half away from zero
+	HalfUp Rounding = iota
+	HalfEven
+	// toward zero
+	Down
+	// away from zero
+	Up
+	Ceiling
+	Floor
+)
+
+var roundings = []string{"half-up", "half-even", "down", "up", "ceiling", "floor"}
+
+func (mode Rounding) String() string {
+	return roundings[mode]
+}
+
+func ParseRounding(name string) (Rounding, error) {
+	for i, mode := range roundings {
+		if mode == name {
+			return Rounding(i), nil
+		}
+	}
+	return HalfUp, fmt.Errorf("unknown rounding mode %q, wanted one of %q", name, roundings)
+}
+
+// policy for results beyond the digit limit
+type Overflow int
+
+const (
+	OverflowError Overflow = iota
+	// clamp to the largest magnitude
+	OverflowSaturate
+)
+
+var overflows = []string{"error", "saturate"}
+
+func (policy Overflow) String() string {
+	return overflows[policy]
+}
+
+func ParseOverflow(name string) (Overflow, error) {
+	for i, policy := range overflows {
+		if policy == name {
+			return Overflow(i), nil
+		}
+	}
+	return OverflowError, fmt.Errorf("unknown overflow policy %q, wanted one of %q", name, overflows)
+}
+
+// numeric context for arithmetic
+type Numeric struct {
+	// digits after the point for inexact results
+	Precision int32
+	Rounding  Rounding
+	// digits before the point, or 0 for no limit
+	MaxDigits int32
+	Overflow  Overflow
+	// division of numbers gives rationals when inexact
+	Exact bool
+}
+
+// bound on precision, max-digits and rounding places, so they fit an int32
+// and division stays fast
+const MaxPrecision = 10000
+
+// same results as shopspring division by default
+var DefaultNumeric = Numeric{Precision: 16, Rounding: HalfUp}
+
+var ErrDivisionByZero = errors.New("division by zero")
+
+// round to places using the rounding mode
+func (num Numeric) RoundTo(d decimal.Decimal, places int32) decimal.Decimal {
+	switch num.Rounding {
+	default:
+		return d.Round(places)
+	case HalfEven:
+		return d.RoundBank(places)
+	case Down:
+		return d.RoundDown(places)
+	case Up:
+		return d.RoundUp(places)
+	case Ceiling:
+		return d.RoundCeil(places)
+	case Floor:
+		return d.RoundFloor(places)
+	}
+}
+
+// round an inexact result to the precision
+func (num Numeric) Round(d decimal.Decimal) decimal.Decimal {
+	return num.RoundTo(d, num.Precision)
+}
+
+// d1 / d2 rounded to the precision
+func (num Numeric) Div(d1, d2 decimal.Decimal) (decimal.Decimal, error) {
+	if d2.IsZero() {
+		return decimal.Zero, ErrDivisionByZero
+	}
+	// truncated quotient with extra digits
+	places := num.Precision + 2
+	q, r := d1.QuoRem(d2, places)
+	if !r.IsZero() {
+		// sticky digit, so a truncated half rounds as above half
+		sticky := decimal.New(1, -places-1)
+		if r.Sign()*d2.Sign() < 0 {
+			sticky = sticky.Neg()
+		}
+		q = q.Add(sticky)
+	}
+	return num.Check(num.Round(q))
+}
+
+// apply the overflow policy
+func (num Numeric) Check(d decimal.Decimal) (decimal.Decimal, error) {
+	// digits before the point
+	if num.MaxDigits <= 0 || int32(d.NumDigits())+d.Exponent() <= num.MaxDigits {
+		return d, nil
+	}
+	if num.Overflow == OverflowSaturate {
+		// largest magnitude with MaxDigits and Precision digits
+		max := decimal.New(1, num.MaxDigits).Sub(decimal.New(1, -num.Precision))
+		if d.IsNegative() {
+			return max.Neg(), nil
+		}
+		return max, nil
+	}
+	return decimal.Zero, fmt.Errorf("numeric overflow: %v exceeds %d digit(s)", d, num.MaxDigits)
+}
@@ -0,0 +1,59 @@
+package eval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNumericDiv(t *testing.T) {
+	tests := []struct {
+		num     Numeric
+		d1, d2  int64
+		want    string
+		wantErr string
+	}{
+		{DefaultNumeric, 1, 3, "0.3333333333333333", ""},
+		{DefaultNumeric, 2, 3, "0.6666666666666667", ""},
+		{Numeric{Precision: 0, Rounding: HalfEven}, 5, 2, "2", ""},
+		{Numeric{Precision: 0, Rounding: HalfEven}, 7, 2, "4", ""},
+		// 0.5005 truncates to 0.50, but is above half
+		{Numeric{Precision: 0, Rounding: HalfEven}, 1001, 2000, "1", ""},
+		{Numeric{Precision: 0, Rounding: Floor}, -1, 3, "-1", ""},
+		{Numeric{Precision: 0, Rounding: Ceiling}, -1, 3, "0", ""},
+		{Numeric{Precision: 0, Rounding: Up}, 1, 3, "1", ""},
+		{Numeric{Precision: 0, Rounding: Down}, -2, 3, "0", ""},
+		{Numeric{Precision: 2, MaxDigits: 1, Overflow: OverflowSaturate}, 100, 3, "9.99", ""},
+		{Numeric{Precision: 2, MaxDigits: 1}, 100, 3, "", "numeric overflow: 33.33 exceeds 1 digit(s)"},
+		{DefaultNumeric, 1, 0, "", "division by zero"},
+	}
+	for _, test := range tests {
+		got, err := test.num.Div(decimal.New(test.d1, 0), decimal.New(test.d2, 0))
+		if test.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("%+v %d/%d: got error %v, want %q", test.num, test.d1, test.d2, err, test.wantErr)
+			}
+			continue
+		}
+		if err != nil || got.String() != test.want {
+			t.Errorf("%+v %d/%d: got %v %v, want %s", test.num, test.d1, test.d2, got, err, test.want)
+		}
+	}
+}
+
+func TestParseSettings(t *testing.T) {
+	for _, mode := range []Rounding{HalfUp, HalfEven, Down, Up, Ceiling, Floor} {
+		if got, err := ParseRounding(mode.String()); err != nil || got != mode {
+			t.Errorf("%s: got %v %v", mode, got, err)
+		}
+	}
+	for _, policy := range []Overflow{OverflowError, OverflowSaturate} {
+		if got, err := ParseOverflow(policy.String()); err != nil || got != policy {
+			t.Errorf("%s: got %v %v", policy, got, err)
+		}
+	}
+	if _, err := ParseRounding("sideways"); err == nil {
+		t.Error("sideways: got no error")
+	}
+}
@@ -2,6 +2,7 @@ package eval
 
 import (
 	"io"
+	"io/ioutil"
 
 	"github.com/arizonahanson/oryx/internal/parser"
 	"github.com/arizonahanson/oryx/pkg/ast"
@@ -9,27 +10,46 @@ import (
 
 // parse a slice of bytes as an ast
 func Parse(in []byte) (ast.Any, error) {
-	val, err := parser.Parse("parse", in)
-	if err != nil {
-		return ast.Null{}, err
-	}
-	return val.(ast.Any), nil
+	return parse("", in, nil)
+}
+
+// parse a slice of bytes as an ast, recording source spans
+func ParseSpans(in []byte, spans *ast.Spans) (ast.Any, error) {
+	return parse("", in, spans)
 }
 
 // parse a file as an ast
 func ParseFile(filename string) (ast.Any, error) {
-	val, err := parser.ParseFile(filename)
+	return ParseFileSpans(filename, nil)
+}
+
+// parse a file as an ast, recording source spans
+func ParseFileSpans(filename string, spans *ast.Spans) (ast.Any, error) {
+	in, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	return val.(ast.Any), nil
+	return parse(filename, in, spans)
 }
 
 // parse reader output as an ast
 func ParseReader(read io.Reader) (ast.Any, error) {
-	val, err := parser.ParseReader("read", read)
+	in, err := ioutil.ReadAll(read)
 	if err != nil {
 		return ast.Null{}, err
 	}
+	return parse("", in, nil)
+}
+
+// syntax errors are returned as Diagnostics
+func parse(filename string, in []byte, spans *ast.Spans) (ast.Any, error) {
+	opts := []parser.Option{}
+	if spans != nil {
+		opts = append(opts, parser.GlobalStore("spans", spans))
+	}
+	val, err := parser.Parse(filename, in, opts...)
+	if err != nil {
+		return ast.Null{}, diagnose(err, filename, in)
+	}
 	return val.(ast.Any), nil
 }
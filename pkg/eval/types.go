@@ -18,6 +18,25 @@ func (future Future) Equal(any ast.Any) bool {
 	return false
 }
 
+// type:call, future that enters the call frame of a user function
+type Call struct {
+	Frame  Frame
+	Future Future
+}
+
+func (call Call) String() string {
+	return call.GoString()
+}
+
+func (call Call) GoString() string {
+	return "???"
+}
+
+func (call Call) Equal(any ast.Any) bool {
+	// not comparable
+	return false
+}
+
 // type:function
 type Func struct {
 	Fn   FuncType
@@ -1,10 +1,13 @@
 package lib
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/arizonahanson/oryx/pkg/ast"
 	"github.com/arizonahanson/oryx/pkg/eval"
+	"github.com/shopspring/decimal"
 )
 
 var BaseLib = map[string]eval.FuncType{
@@ -12,6 +15,13 @@ var BaseLib = map[string]eval.FuncType{
 	"and":    _and,
 	"||":     _or,
 	"or":     _or,
+	"?":      _if,
+	"if":     _if,
+	"cond":   _cond,
+	"when":   _when,
+	"do":     _do,
+	"let":    _let,
+	"loop":   _loop,
 	"==":     _equalQ,
 	"equal?": _equalQ,
 	"!=":     _nequalQ,
@@ -33,32 +43,51 @@ var BaseLib = map[string]eval.FuncType{
 	"div":    _div,
 	"quo":    _quo,
 	"rem":    _rem,
+	"%":      _mod,
+	"mod":    _mod,
+	"**":     _pow,
 	"!":      _not,
 	"not":    _not,
 	":=":     _defE,
 	"def!":   _defE,
 	"func":   _func,
 	"=>":     _func,
+	"macro":  _macro,
+	"eval":   _eval,
+	"throw":  _throw,
+	"try":    _try,
+	"error":  _error,
+
+	"error-message": _errorMessage,
+	"error-kind":    _errorKind,
+	"error-data":    _errorData,
+
+	"quote":            _quote,
+	"quasiquote":       _quasiquote,
+	"unquote":          _unquote,
+	"unquote-splicing": _unquote,
 }
 
 func BaseEnv(outer *eval.Env) *eval.Env {
 	env := eval.NewEnv(outer)
-	for key, fn := range BaseLib {
-		env.SetFunc(key, fn)
+	for _, lib := range []map[string]eval.FuncType{BaseLib, CollLib, MapLib, StringLib, RegexLib, TypeLib, MathLib, NumericLib, RationalLib} {
+		for key, fn := range lib {
+			env.SetFunc(key, fn)
+		}
 	}
 	return env
 }
 
 func exactLen(exp ast.Expr, n int) error {
 	if len(exp) != n {
-		return fmt.Errorf("%#v: wanted %d arg(s), got %d", exp[0], n-1, len(exp)-1)
+		return fmt.Errorf("%v: wanted %d arg(s), got %d", exp[0], n-1, len(exp)-1)
 	}
 	return nil
 }
 
 func minLen(exp ast.Expr, n int) error {
 	if len(exp) < n {
-		return fmt.Errorf("%#v: wanted at least %d arg(s), got %d", exp[0], n-1, len(exp)-1)
+		return fmt.Errorf("%v: wanted at least %d arg(s), got %d", exp[0], n-1, len(exp)-1)
 	}
 	return nil
 }
@@ -73,7 +102,50 @@ func evalNumber(exp ast.Any, env *eval.Env) (*ast.Number, error) {
 		return nil, fmt.Errorf("called with non-number %#v", val)
 	case ast.Number:
 		return &num, nil
+	case ast.Rational:
+		// inexact, rounded by the numeric context
+		res, err := toDecimal(num, env.Numeric())
+		if err != nil {
+			return nil, err
+		}
+		return (*ast.Number)(&res), nil
+	}
+}
+
+// number, if within the digit limit of the numeric context
+func checked(res decimal.Decimal, env *eval.Env) (ast.Any, error) {
+	res, err := env.Numeric().Check(res)
+	if err != nil {
+		return ast.Null{}, err
 	}
+	return ast.Number(res), nil
+}
+
+// name of a called function, from the call site
+func callee(any ast.Any) string {
+	switch fn := any.(type) {
+	case ast.Symbol:
+		return fn.Val
+	case eval.Func:
+		return fn.Name
+	}
+	return "<func>"
+}
+
+// position of a call, from the callee symbol or the source span
+func callSite(exp ast.Expr, env *eval.Env) *ast.Position {
+	if pos := position(exp[0]); pos != nil {
+		return pos
+	}
+	if span := env.Span(exp); span != nil {
+		return &span.Start
+	}
+	return nil
+}
+
+// false and null are falsy, everything else is truthy
+func truthy(val ast.Any) bool {
+	return !val.Equal(ast.Boolean(false)) && !val.Equal(ast.Null{})
 }
 
 func oneArg(exp ast.Expr, env *eval.Env) (ast.Any, error) {
@@ -97,37 +169,31 @@ func _func(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	case ast.Array:
 		break
 	}
-	binds := exp[1].(ast.Array)
-	symbols := make([]ast.Symbol, len(binds))
-	for i, item := range binds {
-		switch sym := item.(type) {
-		default:
-			return ast.Null{}, fmt.Errorf("bind expression contained non-symbol %#v", item)
-		case ast.Symbol:
-			symbols[i] = sym
-			break
-		}
+	binds, err := newParams(exp[1].(ast.Array))
+	if err != nil {
+		return ast.Null{}, err
 	}
 	body := exp[2]
 	fn := func(args ast.Expr, outer *eval.Env) (ast.Any, error) {
-		err := exactLen(args, len(symbols)+1)
-		if err != nil {
+		if err := binds.check(args); err != nil {
 			return ast.Null{}, err
 		}
-		local := eval.NewEnv(env)
-		for i, sym := range symbols {
-			// bind sym to arg in local, but lazy eval arg in outer
-			local.Set(sym, eval.FutureEval(args[i+1], outer))
-		}
-		// future that places breaks in error trace
-		future := func() (val ast.Any, err error) {
-			val, err = eval.Eval(body, local)
+		// eager eval args in outer, so no chains of futures build up
+		vals := make(ast.Array, len(args)-1)
+		for i, item := range args[1:] {
+			val, err := eval.Eval(item, outer)
 			if err != nil {
-				err = fmt.Errorf("error\n  %v", err)
+				return ast.Null{}, err
 			}
-			return
+			vals[i] = val
+		}
+		local := eval.NewEnv(env)
+		if err := binds.bindArgs(vals, local); err != nil {
+			return ast.Null{}, err
 		}
-		return eval.Future(future), nil
+		// body in tail position, resolved by the caller's trampoline
+		frame := eval.Frame{Name: callee(args[0]), Pos: callSite(args, outer)}
+		return eval.Call{Frame: frame, Future: eval.FutureEval(body, local)}, nil
 	}
 	return eval.Func{Fn: fn, Name: "<func>"}, nil
 }
@@ -136,53 +202,202 @@ func _defE(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	if err := exactLen(exp, 3); err != nil {
 		return ast.Null{}, err
 	}
-	switch sym := exp[1].(type) {
-	default:
-		return ast.Null{}, fmt.Errorf("called with non-symbol %#v", exp[1])
-	case ast.Symbol:
+	if sym, ok := exp[1].(ast.Symbol); ok {
 		env.Set(sym, eval.FutureEval(exp[2], env))
 		return ast.Null{}, nil
 	}
+	// destructuring is eager
+	pat, err := newPattern(exp[1])
+	if err != nil {
+		return ast.Null{}, err
+	}
+	val, err := eval.Eval(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Null{}, pat.bind(val, env)
 }
 
-func _add(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	res := ast.Zero.Decimal()
-	for _, item := range exp[1:] {
-		val, err := evalNumber(item, env)
+func _let(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	binds, ok := exp[1].(ast.Array)
+	if !ok {
+		return ast.Null{}, fmt.Errorf("called with non-array %#v", exp[1])
+	}
+	if len(binds)%2 != 0 {
+		return ast.Null{}, fmt.Errorf("bind expression contained odd number of forms %#v", binds)
+	}
+	local := eval.NewEnv(env)
+	for i := 0; i < len(binds); i += 2 {
+		pat, err := newPattern(binds[i])
 		if err != nil {
 			return ast.Null{}, err
 		}
-		res = res.Add(val.Decimal())
+		// eval before binding, so later binds see earlier ones and shadowing works
+		val, err := eval.Eval(binds[i+1], local)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if err := pat.bind(val, local); err != nil {
+			return ast.Null{}, err
+		}
 	}
-	return ast.Number(res), nil
+	return body(exp[2:], local)
 }
 
-func _sub(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	res := ast.Zero.Decimal()
-	for i, item := range exp[1:] {
-		val, err := evalNumber(item, env)
+// type:recur, rebinds the enclosing loop
+type recur struct {
+	args ast.Array
+}
+
+func (val recur) String() string {
+	return val.GoString()
+}
+
+func (val recur) GoString() string {
+	return "<recur>"
+}
+
+func (val recur) Equal(arg ast.Any) bool {
+	// not comparable
+	return false
+}
+
+func _loop(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	binds, ok := exp[1].(ast.Array)
+	if !ok {
+		return ast.Null{}, fmt.Errorf("called with non-array %#v", exp[1])
+	}
+	if len(binds)%2 != 0 {
+		return ast.Null{}, fmt.Errorf("bind expression contained odd number of forms %#v", binds)
+	}
+	pats := make([]pattern, len(binds)/2)
+	local := eval.NewEnv(env)
+	for i := range pats {
+		pat, err := newPattern(binds[i*2])
+		if err != nil {
+			return ast.Null{}, err
+		}
+		val, err := eval.Eval(binds[i*2+1], local)
 		if err != nil {
 			return ast.Null{}, err
 		}
-		if i == 0 && len(exp) > 2 {
-			res = val.Decimal()
-		} else {
-			res = res.Sub(val.Decimal())
+		if err := pat.bind(val, local); err != nil {
+			return ast.Null{}, err
+		}
+		pats[i] = pat
+	}
+	// recur calls this iteration and where the first was, only one in tail position is allowed
+	var (
+		calls int
+		at    *ast.Position
+		done  bool
+	)
+	defer func() {
+		done = true
+	}()
+	// recur evaluates its args and hands them back to the loop
+	fn := func(args ast.Expr, outer *eval.Env) (ast.Any, error) {
+		if done {
+			return ast.Null{}, errors.New("recur: called after its loop ended")
+		}
+		if err := exactLen(args, len(pats)+1); err != nil {
+			return ast.Null{}, err
+		}
+		vals := make(ast.Array, len(pats))
+		for i, item := range args[1:] {
+			val, err := eval.Eval(item, outer)
+			if err != nil {
+				return ast.Null{}, err
+			}
+			vals[i] = val
+		}
+		calls++
+		if at == nil {
+			at = callSite(args, outer)
+		}
+		return recur{vals}, nil
+	}
+	for {
+		if err := env.Err(); err != nil {
+			return ast.Null{}, err
+		}
+		local.SetFunc("recur", fn)
+		val, err := body(exp[2:], local)
+		if err == nil {
+			val, err = eval.Eval(val, local)
+		}
+		if err != nil {
+			return ast.Null{}, err
+		}
+		next, ok := val.(recur)
+		if calls > 1 || calls == 1 && !ok {
+			return ast.Null{}, &eval.Error{Err: errors.New("recur: not in tail position"), Pos: at}
+		}
+		if !ok {
+			return val, nil
+		}
+		calls, at = 0, nil
+		// fresh scope per iteration, so closures keep their bindings
+		local = eval.NewEnv(env)
+		for i, pat := range pats {
+			if err := pat.bind(next.args[i], local); err != nil {
+				return ast.Null{}, err
+			}
 		}
 	}
-	return ast.Number(res), nil
 }
 
-func _mul(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	res := ast.One.Decimal()
-	for _, item := range exp[1:] {
-		val, err := evalNumber(item, env)
-		if err != nil {
+func _do(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return body(exp[1:], env)
+}
+
+// eval each expression, lazy eval the last
+func body(exps []ast.Any, env *eval.Env) (ast.Any, error) {
+	if len(exps) == 0 {
+		return ast.Null{}, nil
+	}
+	for _, item := range exps[:len(exps)-1] {
+		if _, err := eval.Eval(item, env); err != nil {
 			return ast.Null{}, err
 		}
-		res = res.Mul(val.Decimal())
 	}
-	return ast.Number(res), nil
+	return eval.FutureEval(exps[len(exps)-1], env), nil
+}
+
+func _add(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return arith(exp, env, ast.Zero, false,
+		func(x, y decimal.Decimal) (decimal.Decimal, error) {
+			return x.Add(y), nil
+		},
+		func(x, y *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Add(x, y), nil
+		})
+}
+
+func _sub(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return arith(exp, env, ast.Zero, false,
+		func(x, y decimal.Decimal) (decimal.Decimal, error) {
+			return x.Sub(y), nil
+		},
+		func(x, y *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Sub(x, y), nil
+		})
+}
+
+func _mul(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return arith(exp, env, ast.One, false,
+		func(x, y decimal.Decimal) (decimal.Decimal, error) {
+			return x.Mul(y), nil
+		},
+		func(x, y *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Mul(x, y), nil
+		})
 }
 
 func _quo(exp ast.Expr, env *eval.Env) (ast.Any, error) {
@@ -201,8 +416,11 @@ func _quo(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	if err != nil {
 		return ast.Null{}, err
 	}
+	if val2.Decimal().IsZero() {
+		return ast.Null{}, eval.ErrDivisionByZero
+	}
 	q, _ := val1.Decimal().QuoRem(val2.Decimal(), int32(val3.Decimal().IntPart()))
-	return ast.Number(q), nil
+	return checked(q, env)
 }
 
 func _rem(exp ast.Expr, env *eval.Env) (ast.Any, error) {
@@ -221,84 +439,78 @@ func _rem(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	if err != nil {
 		return ast.Null{}, err
 	}
+	if val2.Decimal().IsZero() {
+		return ast.Null{}, eval.ErrDivisionByZero
+	}
 	_, r := val1.Decimal().QuoRem(val2.Decimal(), int32(val3.Decimal().IntPart()))
 	return ast.Number(r), nil
 }
 
-func _div(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	res := ast.One.Decimal()
-	for i, item := range exp[1:] {
-		val, err := evalNumber(item, env)
-		if err != nil {
-			return ast.Null{}, err
-		}
-		if i == 0 && len(exp) > 2 {
-			res = val.Decimal()
-		} else {
-			res = res.Div(val.Decimal())
-		}
+// remainder of truncated division, with the sign of the dividend
+func _mod(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 3); err != nil {
+		return ast.Null{}, err
 	}
-	return ast.Number(res), nil
+	return arith(exp, env, ast.Zero, false,
+		func(x, y decimal.Decimal) (decimal.Decimal, error) {
+			if y.IsZero() {
+				return x, eval.ErrDivisionByZero
+			}
+			_, r := x.QuoRem(y, 0)
+			return r, nil
+		},
+		func(x, y *big.Rat) (*big.Rat, error) {
+			if y.Sign() == 0 {
+				return nil, eval.ErrDivisionByZero
+			}
+			q := new(big.Rat).Quo(x, y)
+			n := new(big.Int).Quo(q.Num(), q.Denom())
+			return new(big.Rat).Sub(x, new(big.Rat).Mul(y, new(big.Rat).SetInt(n))), nil
+		})
+}
+
+// rounded by the numeric context, or exact with rationals
+func _div(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	num := env.Numeric()
+	return arith(exp, env, ast.One, num.Exact, num.Div,
+		func(x, y *big.Rat) (*big.Rat, error) {
+			if y.Sign() == 0 {
+				return nil, eval.ErrDivisionByZero
+			}
+			return new(big.Rat).Quo(x, y), nil
+		})
 }
 
 func _ltQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	if err := exactLen(exp, 3); err != nil {
-		return ast.Null{}, err
-	}
-	val1, err := evalNumber(exp[1], env)
-	if err != nil {
-		return ast.Null{}, err
-	}
-	val2, err := evalNumber(exp[2], env)
+	cmp, err := compare(exp, env)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	return ast.Boolean(val1.Decimal().LessThan(val2.Decimal())), nil
+	return ast.Boolean(cmp < 0), nil
 }
 
 func _lteqQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	if err := exactLen(exp, 3); err != nil {
-		return ast.Null{}, err
-	}
-	val1, err := evalNumber(exp[1], env)
+	cmp, err := compare(exp, env)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	val2, err := evalNumber(exp[2], env)
-	if err != nil {
-		return ast.Null{}, err
-	}
-	return ast.Boolean(val1.Decimal().LessThanOrEqual(val2.Decimal())), nil
+	return ast.Boolean(cmp <= 0), nil
 }
 
 func _gtQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	if err := exactLen(exp, 3); err != nil {
-		return ast.Null{}, err
-	}
-	val1, err := evalNumber(exp[1], env)
+	cmp, err := compare(exp, env)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	val2, err := evalNumber(exp[2], env)
-	if err != nil {
-		return ast.Null{}, err
-	}
-	return ast.Boolean(val1.Decimal().GreaterThan(val2.Decimal())), nil
+	return ast.Boolean(cmp > 0), nil
 }
 
 func _gteqQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
-	if err := exactLen(exp, 3); err != nil {
-		return ast.Null{}, err
-	}
-	val1, err := evalNumber(exp[1], env)
-	if err != nil {
-		return ast.Null{}, err
-	}
-	val2, err := evalNumber(exp[2], env)
+	cmp, err := compare(exp, env)
 	if err != nil {
 		return ast.Null{}, err
 	}
-	return ast.Boolean(val1.Decimal().GreaterThanOrEqual(val2.Decimal())), nil
+	return ast.Boolean(cmp >= 0), nil
 }
 
 func _equalQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
@@ -368,6 +580,56 @@ func _or(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	return eval.FutureEval(exp[len(exp)-1], env), nil
 }
 
+func _if(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	val, err := eval.Eval(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if truthy(val) {
+		return eval.FutureEval(exp[2], env), nil
+	}
+	if len(exp) == 4 {
+		return eval.FutureEval(exp[3], env), nil
+	}
+	return ast.Null{}, nil
+}
+
+func _cond(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	clauses := exp[1:]
+	for len(clauses) > 1 {
+		val, err := eval.Eval(clauses[0], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if truthy(val) {
+			return eval.FutureEval(clauses[1], env), nil
+		}
+		clauses = clauses[2:]
+	}
+	if len(clauses) == 1 {
+		// trailing default
+		return eval.FutureEval(clauses[0], env), nil
+	}
+	return ast.Null{}, nil
+}
+
+func _when(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := eval.Eval(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if !truthy(val) {
+		return ast.Null{}, nil
+	}
+	return body(exp[2:], env)
+}
+
 func _not(exp ast.Expr, env *eval.Env) (ast.Any, error) {
 	val, err := oneArg(exp, env)
 	if err != nil {
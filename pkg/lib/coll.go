@@ -0,0 +1,486 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+	"github.com/shopspring/decimal"
+)
+
+var CollLib = map[string]eval.FuncType{
+	"map":    _map,
+	"filter": _filter,
+	"reduce": _reduce,
+	"count":  _count,
+	"nth":    _nth,
+	"slice":  _slice,
+	// xs[a:b], not rebindable
+	"[:]":     _slice,
+	"first":   _first,
+	"rest":    _rest,
+	"concat":  _concat,
+	"reverse": _reverse,
+	"range":   _range,
+	"take":    _take,
+	"drop":    _drop,
+	"any?":    _anyQ,
+	"every?":  _everyQ,
+}
+
+func evalArray(exp ast.Any, env *eval.Env) (ast.Array, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch arr := val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-array %#v", val)
+	case ast.Array:
+		return arr, nil
+	}
+}
+
+func evalFunc(exp ast.Any, env *eval.Env) (*eval.Func, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch fn := val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-function %#v", val)
+	case eval.Func:
+		return &fn, nil
+	}
+}
+
+func evalInt(exp ast.Any, env *eval.Env) (int, error) {
+	num, err := evalNumber(exp, env)
+	if err != nil {
+		return 0, err
+	}
+	if !num.Decimal().IsInteger() {
+		return 0, fmt.Errorf("called with non-integer %#v", *num)
+	}
+	n := num.Decimal().BigInt()
+	if !n.IsInt64() || n.Int64() > math.MaxInt || n.Int64() < math.MinInt {
+		return 0, fmt.Errorf("integer %#v out of range", *num)
+	}
+	return int(n.Int64()), nil
+}
+
+// eval a function and an array, eg. (filter f xs)
+func evalFuncArray(exp ast.Expr, env *eval.Env) (*eval.Func, ast.Array, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return nil, nil, err
+	}
+	fn, err := evalFunc(exp[1], env)
+	if err != nil {
+		return nil, nil, err
+	}
+	arr, err := evalArray(exp[2], env)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fn, arr, nil
+}
+
+// eval a count and an array, eg. (take n xs)
+func evalIntArray(exp ast.Expr, env *eval.Env) (int, ast.Array, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return 0, nil, err
+	}
+	n, err := evalInt(exp[1], env)
+	if err != nil {
+		return 0, nil, err
+	}
+	arr, err := evalArray(exp[2], env)
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, arr, nil
+}
+
+// index into length n, counting back from the end when negative
+func evalIndex(exp ast.Any, n int, env *eval.Env) (int, error) {
+	i, err := evalInt(exp, env)
+	if err != nil {
+		return 0, err
+	}
+	if i < -n || i >= n {
+		return 0, rangeError{i, n}
+	}
+	if i < 0 {
+		i += n
+	}
+	return i, nil
+}
+
+// index out of range, the only index error a default can replace
+type rangeError struct {
+	index, length int
+}
+
+func (err rangeError) Error() string {
+	return fmt.Sprintf("index %d out of range for length %d", err.index, err.length)
+}
+
+// slice bound in [0, n], or def when null
+func evalBound(exp ast.Any, n int, def int, env *eval.Env) (int, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return 0, err
+	}
+	if val.Equal(ast.Null{}) {
+		return def, nil
+	}
+	i, err := evalInt(eval.Value(val), env)
+	if err != nil {
+		return 0, err
+	}
+	if i < -n || i > n {
+		return 0, fmt.Errorf("slice bound %d out of range for length %d", i, n)
+	}
+	if i < 0 {
+		i += n
+	}
+	return i, nil
+}
+
+// clamp n to [0, len(arr)]
+func clamp(n int, arr ast.Array) int {
+	if n < 0 {
+		return 0
+	}
+	if n > len(arr) {
+		return len(arr)
+	}
+	return n
+}
+
+// (map f xs ys...) stops at the shortest array
+func _map(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	fn, err := evalFunc(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	arrs := make([]ast.Array, len(exp)-2)
+	size := -1
+	for i, item := range exp[2:] {
+		arr, err := evalArray(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		arrs[i] = arr
+		if size < 0 || len(arr) < size {
+			size = len(arr)
+		}
+	}
+	res := make(ast.Array, size)
+	for i := range res {
+		args := make([]ast.Any, len(arrs))
+		for j, arr := range arrs {
+			args[j] = arr[i]
+		}
+		val, err := fn.Apply(args, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		res[i] = val
+	}
+	return res, nil
+}
+
+func _filter(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	fn, arr, err := evalFuncArray(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := ast.Array{}
+	for _, item := range arr {
+		val, err := fn.Apply([]ast.Any{item}, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if truthy(val) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+// (reduce f init xs) or (reduce f xs)
+func _reduce(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	fn, err := evalFunc(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	arr, err := evalArray(exp[len(exp)-1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	var acc ast.Any
+	if len(exp) == 4 {
+		acc, err = eval.Eval(exp[2], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+	} else {
+		if len(arr) == 0 {
+			return ast.Null{}, fmt.Errorf("called with empty array and no initial value")
+		}
+		acc, arr = arr[0], arr[1:]
+	}
+	for _, item := range arr {
+		acc, err = fn.Apply([]ast.Any{acc, item}, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+	}
+	return acc, nil
+}
+
+func _count(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch coll := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("called with non-collection %#v", val)
+	case ast.Array:
+		return ast.NewNumber(int64(len(coll))), nil
+	case ast.Map:
+		return ast.NewNumber(int64(len(coll))), nil
+	}
+}
+
+// (nth xs i) or (nth xs i default)
+func _nth(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	arr, err := evalArray(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	i, err := evalInt(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if i >= 0 && i < len(arr) {
+		return arr[i], nil
+	}
+	if len(exp) == 4 {
+		return eval.FutureEval(exp[3], env), nil
+	}
+	return ast.Null{}, fmt.Errorf("index %d out of range for length %d", i, len(arr))
+}
+
+// (slice coll start end) of an array or string, eg. xs[1:3] xs[:-1]
+func _slice(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	val, err := eval.Eval(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	var n int
+	switch coll := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("called with non-array %#v", val)
+	case ast.Array:
+		n = len(coll)
+	case ast.String:
+		n = len([]rune(coll.Val))
+	}
+	start, err := evalBound(exp[2], n, 0, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	end := n
+	if len(exp) == 4 {
+		end, err = evalBound(exp[3], n, n, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+	}
+	if start > end {
+		return ast.Null{}, fmt.Errorf("slice bounds %d:%d out of order", start, end)
+	}
+	if str, ok := val.(ast.String); ok {
+		return ast.String{Val: string([]rune(str.Val)[start:end])}, nil
+	}
+	return val.(ast.Array)[start:end], nil
+}
+
+func _first(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	arr, err := evalArray(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if len(arr) == 0 {
+		return ast.Null{}, nil
+	}
+	return arr[0], nil
+}
+
+func _rest(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	arr, err := evalArray(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if len(arr) == 0 {
+		return ast.Array{}, nil
+	}
+	return arr[1:], nil
+}
+
+// concatenate arrays, or strings when the first arg is a string
+func _concat(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) > 1 {
+		first, err := eval.Eval(exp[1], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if str, ok := first.(ast.String); ok {
+			return concatStrings(str, exp[2:], env)
+		}
+		exp = append(ast.Expr{exp[0], eval.Value(first)}, exp[2:]...)
+	}
+	res := ast.Array{}
+	for _, item := range exp[1:] {
+		arr, err := evalArray(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		res = append(res, arr...)
+	}
+	return res, nil
+}
+
+func _reverse(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	arr, err := evalArray(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := make(ast.Array, len(arr))
+	for i, item := range arr {
+		res[len(arr)-1-i] = item
+	}
+	return res, nil
+}
+
+// (range end), (range start end) or (range start end step)
+func _range(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) < 2 || len(exp) > 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 1-3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	nums := make([]decimal.Decimal, len(exp)-1)
+	for i, item := range exp[1:] {
+		num, err := evalNumber(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		nums[i] = num.Decimal()
+	}
+	start, end, step := ast.Zero.Decimal(), nums[0], ast.One.Decimal()
+	if len(nums) > 1 {
+		start, end = nums[0], nums[1]
+	}
+	if len(nums) > 2 {
+		step = nums[2]
+	}
+	if step.IsZero() {
+		return ast.Null{}, fmt.Errorf("called with zero step")
+	}
+	res := ast.Array{}
+	for i := start; (step.IsPositive() && i.LessThan(end)) || (step.IsNegative() && i.GreaterThan(end)); i = i.Add(step) {
+		if err := env.Err(); err != nil {
+			return ast.Null{}, err
+		}
+		res = append(res, ast.Number(i))
+	}
+	return res, nil
+}
+
+func _take(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	n, arr, err := evalIntArray(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return arr[:clamp(n, arr)], nil
+}
+
+func _drop(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	n, arr, err := evalIntArray(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return arr[clamp(n, arr):], nil
+}
+
+func _anyQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	fn, arr, err := evalFuncArray(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	for _, item := range arr {
+		val, err := fn.Apply([]ast.Any{item}, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if truthy(val) {
+			return ast.Boolean(true), nil
+		}
+	}
+	return ast.Boolean(false), nil
+}
+
+func _everyQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	fn, arr, err := evalFuncArray(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	for _, item := range arr {
+		val, err := fn.Apply([]ast.Any{item}, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if !truthy(val) {
+			return ast.Boolean(false), nil
+		}
+	}
+	return ast.Boolean(true), nil
+}
+
+func concatStrings(first ast.String, rest []ast.Any, env *eval.Env) (ast.Any, error) {
+	var b strings.Builder
+	b.WriteString(first.Val)
+	for _, item := range rest {
+		str, err := evalString(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		b.WriteString(str.Val)
+	}
+	return ast.String{Val: b.String()}, nil
+}
@@ -0,0 +1,59 @@
+package lib
+
+import "testing"
+
+func TestColl(t *testing.T) {
+	evalsTo(t, []example{
+		{`(map ([x] => (x * 2)) [1 2 3])`, `[2 4 6]`},
+		{`(map add [1 2 3] [10 20])`, `[11 22]`},
+		{`(filter ([x] => (x > 1)) [1 2 3])`, `[2 3]`},
+		{`(reduce add 0 [1 2 3])`, `6`},
+		{`(reduce add [1 2 3])`, `6`},
+		{`(reduce add 5 [])`, `5`},
+		{`(count [1 2 3])`, `3`},
+		{`(count {"a": 1})`, `1`},
+		{`(nth [1 2 3] 1)`, `2`},
+		{`(nth [1 2 3] 5 "none")`, `"none"`},
+		{`(first [1 2])`, `1`},
+		{`(first [])`, `null`},
+		{`(rest [1 2 3])`, `[2 3]`},
+		{`(rest [])`, `[]`},
+		{`(concat [1] [] [2 3])`, `[1 2 3]`},
+		{`(concat "a" "b")`, `"ab"`},
+		{`(reverse [1 2 3])`, `[3 2 1]`},
+		{`(range 3)`, `[0 1 2]`},
+		{`(range 1 7 2)`, `[1 3 5]`},
+		{`(range 3 0 -1)`, `[3 2 1]`},
+		{`(take 2 [1 2 3])`, `[1 2]`},
+		{`(take 5 [1 2])`, `[1 2]`},
+		{`(drop 2 [1 2 3])`, `[3]`},
+		{`(drop -1 [1 2])`, `[1 2]`},
+		{`(any? ([x] => (x > 2)) [1 2 3])`, `true`},
+		{`(any? ([x] => (x > 2)) [])`, `false`},
+		{`(every? ([x] => (x > 0)) [1 2 3])`, `true`},
+		{`(every? ([x] => (x > 1)) [1 2 3])`, `false`},
+	})
+	failsWith(t, []example{
+		{`(map 1 [1])`, `non-function`},
+		{`(reduce add [])`, `empty array and no initial value`},
+		{`(nth [1 2] 2)`, `index 2 out of range for length 2`},
+		{`(nth [1] 1.5)`, `non-integer`},
+		{`(nth [1] 99999999999999999999)`, `out of range`},
+		{`(count 1)`, `non-collection`},
+		{`(range 1 2 0)`, `zero step`},
+	})
+}
+
+func TestSlice(t *testing.T) {
+	evalsTo(t, []example{
+		{`(xs := [1 2 3 4]) [xs[1:3] xs[:-1] xs[2:] xs[:] xs[4:]]`, `[[2 3] [1 2 3] [3 4] [1 2 3 4] []]`},
+		{`(s := "héllo") [s[1:3] s[-2:] s[:0]]`, `["él" "lo" ""]`},
+		{`[(slice [1 2 3] 1) (slice [1 2 3] null 2) (slice "abc" -1 null)]`, `[[2 3] [1 2] "c"]`},
+	})
+	failsWith(t, []example{
+		{`(xs := [1 2 3]) xs[0:4]`, `slice bound 4 out of range for length 3`},
+		{`(xs := [1 2 3]) xs[-4:]`, `slice bound -4 out of range for length 3`},
+		{`(xs := [1 2 3]) xs[2:1]`, `slice bounds 2:1 out of order`},
+		{`(slice {"a": 1} 0)`, `called with non-array`},
+	})
+}
@@ -0,0 +1,174 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+func _throw(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if raised, ok := val.(ast.Error); ok {
+		if raised.Pos == nil {
+			raised.Pos = position(exp[0])
+		}
+		return ast.Null{}, eval.Thrown{Val: raised}
+	}
+	return ast.Null{}, eval.Thrown{Val: val}
+}
+
+func _error(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) < 2 || len(exp) > 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 1-3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	vals := make([]ast.Any, len(exp)-1)
+	for i, item := range exp[1:] {
+		val, err := eval.Eval(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		vals[i] = val
+	}
+	res := ast.Error{Message: vals[0].String(), Kind: "error", Data: ast.Map{}, Pos: position(exp[0])}
+	if len(vals) > 1 {
+		kind, ok := vals[1].(ast.String)
+		if !ok {
+			return ast.Null{}, fmt.Errorf("called with non-string %#v", vals[1])
+		}
+		res.Kind = kind.Val
+	}
+	if len(vals) > 2 {
+		data, ok := vals[2].(ast.Map)
+		if !ok {
+			return ast.Null{}, fmt.Errorf("called with non-map %#v", vals[2])
+		}
+		res.Data = data
+	}
+	return res, nil
+}
+
+func _errorMessage(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := evalError(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: val.Message}, nil
+}
+
+func _errorKind(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := evalError(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: val.Kind}, nil
+}
+
+func _errorData(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := evalError(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if val.Data == nil {
+		return ast.Map{}, nil
+	}
+	return val.Data, nil
+}
+
+func evalError(exp ast.Expr, env *eval.Env) (*ast.Error, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch res := val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-error %#v", val)
+	case ast.Error:
+		return &res, nil
+	}
+}
+
+// (try body... (catch e handler...) (finally cleanup...))
+func _try(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	exps := exp[1:]
+	cleanup, ok := clause(exps, "finally")
+	if ok {
+		exps = exps[:len(exps)-1]
+	}
+	handler, ok := clause(exps, "catch")
+	if ok {
+		if len(handler) < 2 {
+			return ast.Null{}, fmt.Errorf("%#v: wanted at least 1 arg(s), got 0", handler[0])
+		}
+		exps = exps[:len(exps)-1]
+	}
+	for i := range exps {
+		if misplaced, ok := clause(exps[i:i+1], "catch"); ok {
+			return ast.Null{}, fmt.Errorf("%#v: misplaced clause", misplaced[0])
+		}
+		if misplaced, ok := clause(exps[i:i+1], "finally"); ok {
+			return ast.Null{}, fmt.Errorf("%#v: misplaced clause", misplaced[0])
+		}
+	}
+	// resolve eagerly, so errors are raised inside try
+	val, err := do(exps, env)
+	// cancellation can't be caught
+	if err != nil && handler != nil && !errors.Is(err, eval.ErrCancelled) {
+		val, err = catch(err, handler, env)
+	}
+	if cleanup != nil {
+		if _, err := do(cleanup[1:], env); err != nil {
+			return ast.Null{}, err
+		}
+	}
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return val, nil
+}
+
+// last expression if it is (name ...)
+func clause(exps []ast.Any, name string) (ast.Expr, bool) {
+	if len(exps) == 0 {
+		return nil, false
+	}
+	exp, ok := exps[len(exps)-1].(ast.Expr)
+	if !ok || len(exp) == 0 || !exp[0].Equal(ast.Symbol{Val: name}) {
+		return nil, false
+	}
+	return exp, true
+}
+
+// eval each expression eagerly, returning the last
+func do(exps []ast.Any, env *eval.Env) (ast.Any, error) {
+	val, err := body(exps, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return eval.Eval(val, env)
+}
+
+// bind the error value to the catch pattern and eval the handler
+func catch(raised error, handler ast.Expr, env *eval.Env) (ast.Any, error) {
+	pat, err := newPattern(handler[1])
+	if err != nil {
+		return ast.Null{}, err
+	}
+	local := eval.NewEnv(env)
+	if err := pat.bind(eval.ErrorValue(raised), local); err != nil {
+		return ast.Null{}, err
+	}
+	return do(handler[2:], local)
+}
+
+// position of a symbol
+func position(any ast.Any) *ast.Position {
+	if sym, ok := any.(ast.Symbol); ok {
+		return sym.Pos
+	}
+	return nil
+}
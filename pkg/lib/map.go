@@ -0,0 +1,258 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+var MapLib = map[string]eval.FuncType{
+	"get": _get,
+	// m.key and xs[i], not rebindable
+	".":           _get,
+	"[]":          _get,
+	"assoc":       _assoc,
+	"dissoc":      _dissoc,
+	"keys":        _keys,
+	"vals":        _vals,
+	"merge":       _merge,
+	"contains?":   _containsQ,
+	"select-keys": _selectKeys,
+}
+
+func evalMap(exp ast.Any, env *eval.Env) (ast.Map, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch m := val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-map %#v", val)
+	case ast.Map:
+		return m, nil
+	}
+}
+
+func evalString(exp ast.Any, env *eval.Env) (*ast.String, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch str := val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-string %#v", val)
+	case ast.String:
+		return &str, nil
+	}
+}
+
+// shallow copy
+func copyMap(m ast.Map) ast.Map {
+	res := make(ast.Map, len(m))
+	for key, item := range m {
+		res[key] = item
+	}
+	return res
+}
+
+// (get coll key) or (get coll key default), eg. m.key m["key"] xs[0] xs[-1]
+//
+// missing map keys, out-of-range indexes and null give the default,
+// else missing keys and null give null and out-of-range indexes an error
+func _get(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	val, err := eval.Eval(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	var item ast.Any
+	found := false
+	switch coll := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("called with non-collection %#v", val)
+	case ast.Null:
+		found = len(exp) == 3
+		item = coll
+	case ast.Map:
+		key, err := evalString(exp[2], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		item, found = coll[*key]
+		if !found && len(exp) == 3 {
+			return ast.Null{}, nil
+		}
+	case ast.Array:
+		i, err := evalIndex(exp[2], len(coll), env)
+		if err == nil {
+			item, found = coll[i], true
+		} else if !defaulted(err, exp) {
+			return ast.Null{}, err
+		}
+	case ast.String:
+		runes := []rune(coll.Val)
+		i, err := evalIndex(exp[2], len(runes), env)
+		if err == nil {
+			item, found = ast.String{Val: string(runes[i])}, true
+		} else if !defaulted(err, exp) {
+			return ast.Null{}, err
+		}
+	}
+	if !found {
+		return eval.FutureEval(exp[3], env), nil
+	}
+	return item, nil
+}
+
+// whether the default of a get replaces err
+func defaulted(err error, exp ast.Expr) bool {
+	var rangeErr rangeError
+	return len(exp) == 4 && errors.As(err, &rangeErr)
+}
+
+// (assoc m key val & more)
+func _assoc(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) < 4 || len(exp)%2 != 0 {
+		return ast.Null{}, fmt.Errorf("%v: wanted a map and key/value pairs, got %d arg(s)", exp[0], len(exp)-1)
+	}
+	m, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := copyMap(m)
+	for i := 2; i < len(exp); i += 2 {
+		key, err := evalString(exp[i], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		val, err := eval.Eval(exp[i+1], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		res[*key] = val
+	}
+	return res, nil
+}
+
+// (dissoc m & keys)
+func _dissoc(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	m, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := copyMap(m)
+	for _, item := range exp[2:] {
+		key, err := evalString(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		delete(res, *key)
+	}
+	return res, nil
+}
+
+func _keys(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	m, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	keys := m.Keys()
+	res := make(ast.Array, len(keys))
+	for i, key := range keys {
+		res[i] = key
+	}
+	return res, nil
+}
+
+// values in key order
+func _vals(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	m, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	keys := m.Keys()
+	res := make(ast.Array, len(keys))
+	for i, key := range keys {
+		res[i] = m[key]
+	}
+	return res, nil
+}
+
+// later maps take precedence
+func _merge(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	res := ast.Map{}
+	for _, item := range exp[1:] {
+		m, err := evalMap(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		for key, val := range m {
+			res[key] = val
+		}
+	}
+	return res, nil
+}
+
+// map has key, or string has substring
+func _containsQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := eval.Eval(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	key, err := evalString(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch coll := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("called with non-map or string %#v", val)
+	case ast.Map:
+		_, exists := coll[*key]
+		return ast.Boolean(exists), nil
+	case ast.String:
+		return ast.Boolean(strings.Contains(coll.Val, key.Val)), nil
+	}
+}
+
+// (select-keys m [keys]) skips missing keys
+func _selectKeys(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	m, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	keys, err := evalArray(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := ast.Map{}
+	for _, item := range keys {
+		key, ok := item.(ast.String)
+		if !ok {
+			return ast.Null{}, fmt.Errorf("called with non-string %#v", item)
+		}
+		if val, exists := m[key]; exists {
+			res[key] = val
+		}
+	}
+	return res, nil
+}
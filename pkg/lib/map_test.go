@@ -0,0 +1,48 @@
+package lib
+
+import "testing"
+
+func TestMap(t *testing.T) {
+	evalsTo(t, []example{
+		{`(get {"a": 1} "a")`, `1`},
+		{`(get {"a": 1} "b")`, `null`},
+		{`(get {"a": 1} "b" 2)`, `2`},
+		{`(assoc {"a": 1} "b" 2 "a" 3)`, `{"a":3 "b":2}`},
+		{`(dissoc {"a": 1 "b": 2 "c": 3} "a" "c" "d")`, `{"b":2}`},
+		{`(keys {"b": 1 "a": 2 "c": 3})`, `["a" "b" "c"]`},
+		{`(vals {"b": 1 "a": 2 "c": 3})`, `[2 1 3]`},
+		{`(merge {"a": 1 "b": 1} {"b": 2} {"c": 3})`, `{"a":1 "b":2 "c":3}`},
+		{`(merge)`, `{}`},
+		{`(contains? {"a": null} "a")`, `true`},
+		{`(contains? {"a": 1} "b")`, `false`},
+		{`(select-keys {"a": 1 "b": 2 "c": 3} ["a" "c" "d"])`, `{"a":1 "c":3}`},
+		// the original is unchanged
+		{`(let [m {"a": 1}] (do (assoc m "a" 2) m))`, `{"a":1}`},
+	})
+	failsWith(t, []example{
+		{`(assoc {"a": 1} "b")`, `wanted a map and key/value pairs`},
+		{`(assoc [1] "a" 1)`, `non-map`},
+		{`(keys {"a": 1} {"b": 2})`, `wanted 1 arg(s), got 2`},
+		{`(get 1 "a")`, `non-collection`},
+	})
+}
+
+func TestGet(t *testing.T) {
+	evalsTo(t, []example{
+		{`(m := {"a": {"b": [1 2 3]}}) [m.a.b m["a"]["b"] m.a.b[0] m.a.b[-1]]`, `[[1 2 3] [1 2 3] 1 3]`},
+		{`(m := {"a": 1}) [m.x m["x"] (get m "x" 2)]`, `[null null 2]`},
+		{`(xs := [1 2 3]) [xs[1] xs[-3] (get xs 3 0) (get xs -4 0)]`, `[2 1 0 0]`},
+		{`(s := "héllo") [s[1] s[-1] (get s 5 "")]`, `["é" "o" ""]`},
+		{`[(get null 0) (get null "a" 1)]`, `[null 1]`},
+		// a default replaces only an out-of-range index
+		{`(get [1 2] 2 (1 + 1))`, `2`},
+	})
+	failsWith(t, []example{
+		{`(xs := [1 2 3]) xs[3]`, `index 3 out of range for length 3`},
+		{`(xs := [1 2 3]) xs[-4]`, `index -4 out of range for length 3`},
+		{`(get [1 2] "a" 0)`, `"a"`},
+		{`(get [1 2] 0.5 0)`, `0.5`},
+		{`(get 1 0)`, `called with non-collection 1`},
+		{`(get [1])`, `wanted 2 or 3 arg(s), got 1`},
+	})
+}
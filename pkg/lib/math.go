@@ -0,0 +1,524 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+	"github.com/shopspring/decimal"
+)
+
+var MathLib = map[string]eval.FuncType{
+	"abs":      _abs,
+	"min":      _min,
+	"max":      _max,
+	"floor":    places(decimal.Decimal.RoundFloor),
+	"ceil":     places(decimal.Decimal.RoundCeil),
+	"round":    _round,
+	"truncate": places(decimal.Decimal.Truncate),
+	"pow":      _pow,
+	"sqrt":     _sqrt,
+	"exp":      _exp,
+	"ln":       _ln,
+	"log10":    _log10,
+	"sin":      trig(sin),
+	"cos":      trig(cos),
+	"tan":      _tan,
+}
+
+// extra digits for intermediate results
+const guard = 8
+
+func evalDecimals(exp ast.Expr, env *eval.Env, n int) ([]decimal.Decimal, error) {
+	if err := exactLen(exp, n+1); err != nil {
+		return nil, err
+	}
+	res := make([]decimal.Decimal, n)
+	for i, item := range exp[1:] {
+		num, err := evalNumber(item, env)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = num.Decimal()
+	}
+	return res, nil
+}
+
+func evalPositive(exp ast.Expr, env *eval.Env) (decimal.Decimal, error) {
+	args, err := evalDecimals(exp, env, 1)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !args[0].IsPositive() {
+		return decimal.Zero, fmt.Errorf("called with non-positive %v", args[0])
+	}
+	return args[0], nil
+}
+
+func _abs(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := evalReal(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if rat, ok := val.(ast.Rational); ok {
+		return ast.NewRational(new(big.Rat).Abs(rat.Rat())), nil
+	}
+	return ast.Number(val.(ast.Number).Decimal().Abs()), nil
+}
+
+func _min(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return extreme(exp, env, -1)
+}
+
+func _max(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return extreme(exp, env, 1)
+}
+
+// the arg that compares as sign against all others
+func extreme(exp ast.Expr, env *eval.Env, sign int) (ast.Any, error) {
+	if err := minLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	args, _, err := evalReals(exp[1:], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res := args[0]
+	for _, arg := range args[1:] {
+		if toRat(arg).Cmp(toRat(res)) == sign {
+			res = arg
+		}
+	}
+	return res, nil
+}
+
+// rounding to N places, eg. (round x) or (round x 2)
+func places(fn func(decimal.Decimal, int32) decimal.Decimal) eval.FuncType {
+	return func(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+		if len(exp) != 2 && len(exp) != 3 {
+			return ast.Null{}, fmt.Errorf("%v: wanted 1 or 2 arg(s), got %d", exp[0], len(exp)-1)
+		}
+		num, err := evalNumber(exp[1], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		n := int32(0)
+		if len(exp) == 3 {
+			n, err = evalPlaces(exp[2], -eval.MaxPrecision, env)
+			if err != nil {
+				return ast.Null{}, err
+			}
+		}
+		return ast.Number(fn(num.Decimal(), n)), nil
+	}
+}
+
+// decimal places in [min, eval.MaxPrecision]
+func evalPlaces(exp ast.Any, min int, env *eval.Env) (int32, error) {
+	n, err := evalInt(exp, env)
+	if err != nil {
+		return 0, err
+	}
+	if n < min || n > eval.MaxPrecision {
+		return 0, fmt.Errorf("places %d out of range [%d, %d]", n, min, eval.MaxPrecision)
+	}
+	return int32(n), nil
+}
+
+// rounding mode of the numeric context
+func _round(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return places(env.Numeric().RoundTo)(exp, env)
+}
+
+// inexact result rounded by the numeric context
+func inexact(res decimal.Decimal, env *eval.Env) (ast.Any, error) {
+	return checked(env.Numeric().Round(res), env)
+}
+
+// trig function of radians
+func trig(fn func(decimal.Decimal, int32) decimal.Decimal) eval.FuncType {
+	return func(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+		args, err := evalDecimals(exp, env, 1)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		return inexact(fn(args[0], env.Numeric().Precision+guard), env)
+	}
+}
+
+// exact for integer exponents
+func _pow(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	base, err := evalReal(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if rat, ok := base.(ast.Rational); ok {
+		return powRat(rat, exp[2], env)
+	}
+	num, err := evalNumber(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	x, y := base.(ast.Number).Decimal(), num.Decimal()
+	if x.IsZero() && y.IsNegative() {
+		return ast.Null{}, fmt.Errorf("called with zero base and negative exponent %v", y)
+	}
+	if y.IsInteger() {
+		n := y.Abs().BigInt()
+		sign := int64(1)
+		if x.IsNegative() && n.Bit(0) == 1 {
+			sign = -1
+		}
+		if !x.IsZero() {
+			size := y.InexactFloat64() * magnitude(x)
+			if overflows(size, env) {
+				return overflow(sign, env)
+			}
+			// only a negative exponent rounds, the power itself is exact
+			if y.IsNegative() && underflows(size, env) {
+				return underflow(sign, env)
+			}
+		}
+		// the exact power has n times the places of x
+		if places := float64(-x.Exponent()) * y.Abs().InexactFloat64(); places > eval.MaxPrecision {
+			return ast.Null{}, fmt.Errorf("called with exponent %v, exact result exceeds %d places", y, eval.MaxPrecision)
+		}
+		res, err := powInt(x, n, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		if y.IsNegative() {
+			res, err = env.Numeric().Div(decimal.New(1, 0), res)
+			if err != nil {
+				return ast.Null{}, err
+			}
+		}
+		return checked(res, env)
+	}
+	if x.IsNegative() {
+		return ast.Null{}, fmt.Errorf("called with negative base %v and non-integer exponent %v", x, y)
+	}
+	if x.IsZero() {
+		return ast.Zero, nil
+	}
+	// x^y = e^(y ln x)
+	size := y.InexactFloat64() * magnitude(x)
+	if overflows(size, env) {
+		return overflow(1, env)
+	}
+	if underflows(size, env) {
+		return underflow(1, env)
+	}
+	// ln x to enough places for the digits of y and of the result
+	prec := env.Numeric().Precision + guard
+	places := prec + int32(math.Max(size, 0)+math.Max(magnitude(y), 0)) + 1
+	res, err := expon(y.Mul(ln(x, places)), prec, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return inexact(res, env)
+}
+
+// exact power of a rational, for integer exponents
+func powRat(x ast.Rational, exp ast.Any, env *eval.Env) (ast.Any, error) {
+	n, err := evalInt(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	rat := x.Rat()
+	if rat.Sign() == 0 && n < 0 {
+		return ast.Null{}, fmt.Errorf("called with zero base and negative exponent %d", n)
+	}
+	// digits of the exact numerator and denominator
+	size := math.Abs(float64(n)) * float64(rat.Num().BitLen()+rat.Denom().BitLen()) * math.Log10(2)
+	if size > eval.MaxPrecision {
+		return ast.Null{}, fmt.Errorf("called with exponent %d, exact result exceeds %d digits", n, eval.MaxPrecision)
+	}
+	if n < 0 {
+		rat.Inv(rat)
+		n = -n
+	}
+	e := big.NewInt(int64(n))
+	num := new(big.Int).Exp(rat.Num(), e, nil)
+	den := new(big.Int).Exp(rat.Denom(), e, nil)
+	return fromRat(new(big.Rat).SetFrac(num, den), env)
+}
+
+// exponentiation by squaring
+func powInt(x decimal.Decimal, n *big.Int, env *eval.Env) (decimal.Decimal, error) {
+	res := decimal.New(1, 0)
+	for i := n.BitLen() - 1; i >= 0; i-- {
+		if err := env.Err(); err != nil {
+			return decimal.Zero, err
+		}
+		res = res.Mul(res)
+		if n.Bit(i) == 1 {
+			res = res.Mul(x)
+		}
+	}
+	return res, nil
+}
+
+// about log10 |x| of non-zero x, to bound a result before computing it
+func magnitude(x decimal.Decimal) float64 {
+	e := int32(x.NumDigits()) + x.Exponent() - 1
+	return math.Log10(x.Abs().Shift(-e).InexactFloat64()) + float64(e)
+}
+
+// a result of about 10^size has more digits before the point than max-digits,
+// or than MaxPrecision without a limit
+func overflows(size float64, env *eval.Env) bool {
+	return size > float64(digitLimit(env))
+}
+
+func digitLimit(env *eval.Env) int32 {
+	if max := env.Numeric().MaxDigits; max > 0 {
+		return max
+	}
+	return eval.MaxPrecision
+}
+
+// result beyond the digit limit, saturated or an error by the overflow policy
+func overflow(sign int64, env *eval.Env) (ast.Any, error) {
+	num := env.Numeric()
+	if num.MaxDigits > 0 && num.Overflow == eval.OverflowSaturate {
+		return checked(decimal.New(sign, num.MaxDigits), env)
+	}
+	return ast.Null{}, fmt.Errorf("numeric overflow: result exceeds %d digit(s)", digitLimit(env))
+}
+
+// a result of about 10^size is within a place of 0 at the precision
+func underflows(size float64, env *eval.Env) bool {
+	return size < float64(-env.Numeric().Precision-2)
+}
+
+// result within a place of 0, rounded as any such value of its sign
+func underflow(sign int64, env *eval.Env) (ast.Any, error) {
+	return inexact(decimal.New(sign, -env.Numeric().Precision-2), env)
+}
+
+func _sqrt(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalDecimals(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	x := args[0]
+	if x.IsNegative() {
+		return ast.Null{}, fmt.Errorf("called with negative %v", x)
+	}
+	// enough bits for the integer digits and the precision
+	prec := int(env.Numeric().Precision) + guard
+	digits := x.NumDigits() + prec
+	if e := int(x.Exponent()); e < 0 {
+		digits -= e
+	}
+	f, _, err := big.ParseFloat(x.String(), 10, uint(digits*4), big.ToNearestEven)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	res, err := decimal.NewFromString(f.Sqrt(f).Text('f', prec))
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return inexact(res, env)
+}
+
+func _exp(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalDecimals(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	x := args[0]
+	// e^x is about 10^(x / ln 10)
+	size := x.InexactFloat64() / math.Ln10
+	if overflows(size, env) {
+		return overflow(1, env)
+	}
+	if underflows(size, env) {
+		return underflow(1, env)
+	}
+	res, err := expon(x, env.Numeric().Precision+guard, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return inexact(res, env)
+}
+
+// e^x to prec places, from the series for x / 2^k squared k times,
+// as the series alone is slow for large x
+func expon(x decimal.Decimal, prec int32, env *eval.Env) (decimal.Decimal, error) {
+	one := decimal.New(1, 0)
+	if x.IsNegative() {
+		res, err := expon(x.Neg(), prec, env)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return one.DivRound(res, prec), nil
+	}
+	y, k := x, 0
+	for y.GreaterThan(one) {
+		y = y.Mul(decimal.New(5, -1))
+		k++
+	}
+	// significant digits for the integer digits of e^x,
+	// and the error doubling with each squaring
+	digits := prec + int32(x.InexactFloat64()/math.Ln10) + int32(k)*3/10 + guard
+	res, err := y.ExpTaylor(digits)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	for i := 0; i < k; i++ {
+		if err := env.Err(); err != nil {
+			return decimal.Zero, err
+		}
+		res = res.Mul(res)
+		res = res.Round(digits - int32(res.NumDigits()) - res.Exponent())
+	}
+	return res.Round(prec), nil
+}
+
+func _ln(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	x, err := evalPositive(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return inexact(ln(x, env.Numeric().Precision+guard), env)
+}
+
+func _log10(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	x, err := evalPositive(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	prec := env.Numeric().Precision + guard
+	res := ln(x, prec).DivRound(ln(decimal.New(10, 0), prec), prec)
+	return inexact(res, env)
+}
+
+// natural log of positive x to prec places
+func ln(x decimal.Decimal, prec int32) decimal.Decimal {
+	// x = m * 2^k * 10^e, with m in [0.75, 1.5]
+	e := int64(x.NumDigits()) + int64(x.Exponent()) - 1
+	m := x.Shift(int32(-e))
+	k := int64(0)
+	for m.GreaterThan(decimal.New(15, -1)) {
+		m = m.Mul(decimal.New(5, -1))
+		k++
+	}
+	// ln 10 = ln 1.25 + 3 ln 2
+	ln2 := atanh2(decimal.New(2, 0), prec)
+	res := atanh2(m, prec).Add(ln2.Mul(decimal.New(k+3*e, 0)))
+	if e != 0 {
+		res = res.Add(atanh2(decimal.New(125, -2), prec).Mul(decimal.New(e, 0)))
+	}
+	return res.Round(prec)
+}
+
+// ln x = 2 atanh((x-1)/(x+1)), converging quickly for x near 1
+func atanh2(x decimal.Decimal, prec int32) decimal.Decimal {
+	one := decimal.New(1, 0)
+	z := x.Sub(one).DivRound(x.Add(one), prec)
+	z2 := z.Mul(z).Round(prec)
+	eps := decimal.New(1, -prec)
+	sum, term := z, z
+	for n := int64(3); ; n += 2 {
+		term = term.Mul(z2).Round(prec)
+		next := term.DivRound(decimal.New(n, 0), prec)
+		if next.Abs().LessThan(eps) {
+			break
+		}
+		sum = sum.Add(next)
+	}
+	return sum.Mul(decimal.New(2, 0))
+}
+
+func sin(x decimal.Decimal, prec int32) decimal.Decimal {
+	x = reduce(x, prec)
+	return taylor(x, x, 1, prec)
+}
+
+func cos(x decimal.Decimal, prec int32) decimal.Decimal {
+	return taylor(reduce(x, prec), decimal.New(1, 0), 0, prec)
+}
+
+// sin / cos, with cos to more places near a pole
+func _tan(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalDecimals(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	x, prec := args[0], env.Numeric().Precision+guard
+	c := cos(x, prec)
+	// each leading zero of cos loses a significant digit of cos,
+	// and adds an integer digit to the result
+	if n := -(int32(c.NumDigits()) + c.Exponent()); n > 0 && !c.IsZero() {
+		prec += 2 * n
+		c = cos(x, prec)
+	}
+	if c.IsZero() {
+		return ast.Null{}, fmt.Errorf("called with %v, too near a pole", x)
+	}
+	return inexact(sin(x, prec).DivRound(c, prec), env)
+}
+
+// x in [-pi, pi], with pi precise enough for the integer digits of x
+func reduce(x decimal.Decimal, prec int32) decimal.Decimal {
+	if n := int32(x.NumDigits()) + x.Exponent(); n > 0 {
+		prec += n
+	}
+	pi := machin(prec)
+	twoPi := pi.Mul(decimal.New(2, 0))
+	x = x.Sub(twoPi.Mul(x.DivRound(twoPi, 0)))
+	if x.GreaterThan(pi) {
+		x = x.Sub(twoPi)
+	} else if x.LessThan(pi.Neg()) {
+		x = x.Add(twoPi)
+	}
+	return x
+}
+
+// first - first x^2/((n+1)(n+2)) + ..., the sin and cos series
+func taylor(x, first decimal.Decimal, n int64, prec int32) decimal.Decimal {
+	x2 := x.Mul(x).Neg()
+	eps := decimal.New(1, -prec)
+	sum, term := first, first
+	for {
+		term = term.Mul(x2).DivRound(decimal.New((n+1)*(n+2), 0), prec)
+		n += 2
+		if term.Abs().LessThan(eps) {
+			return sum
+		}
+		sum = sum.Add(term)
+	}
+}
+
+// pi = 16 atan(1/5) - 4 atan(1/239)
+func machin(prec int32) decimal.Decimal {
+	a := atanInv(5, prec).Mul(decimal.New(16, 0))
+	b := atanInv(239, prec).Mul(decimal.New(4, 0))
+	return a.Sub(b)
+}
+
+// atan(1/k) = 1/k - 1/(3k^3) + 1/(5k^5) - ...
+func atanInv(k int64, prec int32) decimal.Decimal {
+	x := decimal.New(1, 0).DivRound(decimal.New(k, 0), prec)
+	x2 := x.Mul(x).Neg()
+	eps := decimal.New(1, -prec)
+	sum, term := x, x
+	for n := int64(3); ; n += 2 {
+		term = term.Mul(x2).Round(prec)
+		next := term.DivRound(decimal.New(n, 0), prec)
+		if next.Abs().LessThan(eps) {
+			return sum
+		}
+		sum = sum.Add(next)
+	}
+}
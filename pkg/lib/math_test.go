@@ -0,0 +1,51 @@
+package lib
+
+import "testing"
+
+func TestMath(t *testing.T) {
+	evalsTo(t, []example{
+		{`[(abs -1.5) (abs -1/2r)]`, `[1.5 1/2r]`},
+		{`[(min 3 1 2) (max 3 1/2r 2)]`, `[1 3]`},
+		{`[(floor -1.5) (ceil 1.01) (round 2.5) (round -2.5) (truncate -1.99)]`, `[-2 2 3 -3 -1]`},
+		{`[(floor 1.2345 2) (round 1.2345 3) (round 1250 -2)]`, `[1.23 1.235 1300]`},
+		{`[(pow 2 10) (pow 2 -2) (pow -2 3) (pow 0 0) (pow 1.5 2)]`, `[1024 0.25 -8 1 2.25]`},
+		{`(pow 2 0.5)`, `1.414213562373095`},
+		{`(pow 2 -100000)`, `0`},
+		{`[(sqrt 2) (sqrt 16) (sqrt 0)]`, `[1.414213562373095 4 0]`},
+		{`[(exp 0) (exp 1) (exp -1)]`, `[1 2.7182818284590452 0.3678794411714423]`},
+		{`(exp -1000)`, `0`},
+		{`[(ln 1) (ln 10) (log10 1000) (log10 2)]`, `[0 2.3025850929940457 3 0.3010299956639812]`},
+		{`[(sin 0) (sin 1) (cos 1) (tan 1)]`, `[0 0.8414709848078965 0.5403023058681397 1.5574077246549022]`},
+		// near a pole of tan, cos keeps its significant digits
+		{`(tan 1.5707963267948966)`, `51998506188720270.6601947416612269`},
+		{`(with-numeric {"max-digits": 5 "overflow": "saturate"} (pow 2 100))`, `99999.9999999999999999`},
+	})
+	failsWith(t, []example{
+		{`(pow 0 -1)`, `zero base and negative exponent`},
+		{`(pow -8 0.5)`, `negative base -8 and non-integer exponent`},
+		{`(pow 2 100000000)`, `numeric overflow: result exceeds 10000 digit(s)`},
+		{`(pow 0.5 20000)`, `exact result exceeds 10000 places`},
+		{`(exp 1000000)`, `numeric overflow`},
+		{`(with-numeric {"max-digits": 5} (pow 10 5.5))`, `exceeds 5 digit(s)`},
+		{`(sqrt -1)`, `negative -1`},
+		{`(ln 0)`, `non-positive 0`},
+		{`(tan 1.5707963267948966192313216916)`, `too near a pole`},
+		{`(round 1 10001)`, `places 10001 out of range`},
+	})
+}
+
+func TestOperators(t *testing.T) {
+	evalsTo(t, []example{
+		{`(x := 3) [(-x) (- x) (- -x) (-(1 + 2))]`, `[-3 -3 3 -3]`},
+		{`[(2 ** 3 ** 2) (-2 ** 2) (2 ** -1) (2 * 3 ** 2)]`, `[512 -4 0.5 18]`},
+		{`[(7 % 3) (-7 % 3) (7 % -3) (7.5 % 2) (7/2r % 1)]`, `[1 -1 1 1.5 1/2r]`},
+		{`[(mod 7 3) (1 + 2 * 3 % 4)]`, `[1 3]`},
+		{`[(quo 7 2 0) (rem 7 2 0) (quo 1 3 2) (rem 1 3 2)]`, `[3 1 0.33 0.01]`},
+	})
+	failsWith(t, []example{
+		{`(7 % 0)`, `division by zero`},
+		{`(quo 1 0 0)`, `division by zero`},
+		{`(rem 1 2)`, `wanted 3 arg(s), got 2`},
+		{`(- "a")`, `called with non-number "a"`},
+	})
+}
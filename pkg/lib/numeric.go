@@ -0,0 +1,110 @@
+package lib
+
+import (
+	"fmt"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+	"github.com/shopspring/decimal"
+)
+
+var NumericLib = map[string]eval.FuncType{
+	"numeric":      _numeric,
+	"numeric!":     _numericE,
+	"with-numeric": _withNumeric,
+}
+
+// numeric context as a map
+func numericMap(num eval.Numeric) ast.Map {
+	return ast.Map{
+		ast.String{Val: "precision"}:  ast.NewNumber(int64(num.Precision)),
+		ast.String{Val: "rounding"}:   ast.String{Val: num.Rounding.String()},
+		ast.String{Val: "max-digits"}: ast.NewNumber(int64(num.MaxDigits)),
+		ast.String{Val: "overflow"}:   ast.String{Val: num.Overflow.String()},
+		ast.String{Val: "exact"}:      ast.Boolean(num.Exact),
+	}
+}
+
+// numeric context with the settings of a map
+func withSettings(num eval.Numeric, settings ast.Map) (eval.Numeric, error) {
+	for _, key := range settings.Keys() {
+		val := settings[key]
+		var err error
+		switch key.Val {
+		default:
+			return num, fmt.Errorf("unknown numeric setting %#v", key)
+		case "precision":
+			num.Precision, err = setting(key, val)
+		case "max-digits":
+			num.MaxDigits, err = setting(key, val)
+		case "rounding":
+			num.Rounding, err = eval.ParseRounding(val.String())
+		case "overflow":
+			num.Overflow, err = eval.ParseOverflow(val.String())
+		case "exact":
+			exact, ok := val.(ast.Boolean)
+			if !ok {
+				return num, fmt.Errorf("numeric setting %#v wanted boolean, got %#v", key, val)
+			}
+			num.Exact = bool(exact)
+		}
+		if err != nil {
+			return num, err
+		}
+	}
+	return num, nil
+}
+
+// integer setting in [0, eval.MaxPrecision]
+func setting(key ast.String, val ast.Any) (int32, error) {
+	n, ok := val.(ast.Number)
+	if !ok || !n.Decimal().IsInteger() || n.Decimal().IsNegative() {
+		return 0, fmt.Errorf("numeric setting %#v wanted non-negative integer, got %#v", key, val)
+	}
+	if n.Decimal().GreaterThan(decimal.NewFromInt(eval.MaxPrecision)) {
+		return 0, fmt.Errorf("numeric setting %#v must be at most %d, got %#v", key, eval.MaxPrecision, val)
+	}
+	return int32(n.Decimal().IntPart()), nil
+}
+
+func _numeric(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 1); err != nil {
+		return ast.Null{}, err
+	}
+	return numericMap(env.Numeric()), nil
+}
+
+// set numeric context in the current scope, eg. (numeric! {"precision": 4})
+func _numericE(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	settings, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	num, err := withSettings(env.Numeric(), settings)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	env.SetNumeric(num)
+	return numericMap(num), nil
+}
+
+// eval body in a scope with numeric settings, eg. (with-numeric {"rounding": "half-even"} body)
+func _withNumeric(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	settings, err := evalMap(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	num, err := withSettings(env.Numeric(), settings)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	local := eval.NewEnv(env)
+	local.SetNumeric(num)
+	return body(exp[2:], local)
+}
@@ -0,0 +1,32 @@
+package lib
+
+import "testing"
+
+func TestNumeric(t *testing.T) {
+	evalsTo(t, []example{
+		{`(1 / 3)`, `0.3333333333333333`},
+		{`(2 / 3)`, `0.6666666666666667`},
+		{`(numeric)`, `{"exact":false "max-digits":0 "overflow":"error" "precision":16 "rounding":"half-up"}`},
+		{`(with-numeric {"precision": 2 "rounding": "half-even"} [(1 / 8) (3 / 8) (round 2.5)])`, `[0.12 0.38 2]`},
+		{`(with-numeric {"precision": 0 "rounding": "down"} [(2 / 3) (-2 / 3)])`, `[0 0]`},
+		{`(with-numeric {"precision": 0 "rounding": "up"} [(1 / 3) (-1 / 3)])`, `[1 -1]`},
+		{`(with-numeric {"precision": 0 "rounding": "floor"} [(1 / 3) (-1 / 3)])`, `[0 -1]`},
+		{`(with-numeric {"precision": 0 "rounding": "ceiling"} [(1 / 3) (-1 / 3)])`, `[1 0]`},
+		{`(with-numeric {"max-digits": 3 "overflow": "saturate" "precision": 2} [(999 * 2) (-999 - 2)])`, `[999.99 -999.99]`},
+		// scoped to the body
+		{`(do (with-numeric {"precision": 2} (1 / 3)) (1 / 3))`, `0.3333333333333333`},
+		// or to the rest of the scope
+		{`(do (numeric! {"precision": 4}) (1 / 3))`, `0.3333`},
+		// a function keeps the context of the scope defining it
+		{`(let [f ([] => (1 / 3))] (with-numeric {"precision": 1} (f)))`, `0.3333333333333333`},
+	})
+	failsWith(t, []example{
+		{`(1 / 0)`, `division by zero`},
+		{`(with-numeric {"max-digits": 3} (999 + 1))`, `numeric overflow: 1000 exceeds 3 digit(s)`},
+		{`(numeric! {"rounding": "sideways"})`, `unknown rounding mode "sideways"`},
+		{`(numeric! {"overflow": "wrap"})`, `unknown overflow policy "wrap"`},
+		{`(numeric! {"precision": -1})`, `wanted non-negative integer, got -1`},
+		{`(numeric! {"max-digits": 10001})`, `must be at most 10000, got 10001`},
+		{`(numeric! {"scale": 2})`, `unknown numeric setting`},
+	})
+}
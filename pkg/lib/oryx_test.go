@@ -0,0 +1,82 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+// source, and the GoString of its value or a part of its error message
+type example struct {
+	src, want string
+}
+
+// eval each example in a fresh environment
+func evalsTo(t *testing.T, examples []example) {
+	t.Helper()
+	for _, ex := range examples {
+		val, err := DoString(ex.src, eval.NewEnv(nil))
+		if err != nil {
+			t.Errorf("%s: %v", ex.src, err)
+			continue
+		}
+		if got := val.GoString(); got != ex.want {
+			t.Errorf("%s: got %s, want %s", ex.src, got, ex.want)
+		}
+	}
+}
+
+// eval each example in a fresh environment, wanting an error
+func failsWith(t *testing.T, examples []example) {
+	t.Helper()
+	for _, ex := range examples {
+		val, err := DoString(ex.src, eval.NewEnv(nil))
+		if err == nil {
+			t.Errorf("%s: got %s, want error %q", ex.src, val.GoString(), ex.want)
+			continue
+		}
+		if !strings.Contains(err.Error(), ex.want) {
+			t.Errorf("%s: got error %q, want %q", ex.src, err, ex.want)
+		}
+	}
+}
+
+func TestPipelines(t *testing.T) {
+	evalsTo(t, []example{
+		{`("abc" |> upper)`, `"ABC"`},
+		{`([1 2 3] |> type-of)`, `"array"`},
+		{`([1 2 3] |> (get 0))`, `1`},
+		{`([1 2 3] |> nth 1)`, `2`},
+		{`([1 2 3 4] |>> filter ([x] => (x > 1)) |>> map ([x] => (x * 10)) |> count)`, `3`},
+		{`([1 2 3] |>> reduce add 0 |> ([x] => (x * 2)))`, `12`},
+		{`(2 |> (func [x] (x + 1)) |> sub 10)`, `-7`},
+		// left-associative, and looser than arithmetic
+		{`(1 + 2 |> str "x")`, `"3x"`},
+	})
+	failsWith(t, []example{
+		{`([1 2 3] |> nth 5)`, `out of range`},
+	})
+}
+
+func TestCancellation(t *testing.T) {
+	tests := []string{
+		`(loop [i 0] (recur (i + 1)))`,
+		// try doesn't catch a cancellation
+		`(try (loop [i 0] (recur (i + 1))) ([e] => e))`,
+		`(reduce add 0 (range 1000000000))`,
+	}
+	for _, src := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		env := eval.NewEnv(nil)
+		env.SetContext(ctx)
+		_, err := DoString(src, env)
+		cancel()
+		if !errors.Is(err, eval.ErrCancelled) {
+			t.Errorf("%s: got error %v, want %v", src, err, eval.ErrCancelled)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package lib
+
+import (
+	"fmt"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+// binding target: symbol, array or map pattern
+type pattern interface {
+	bind(val ast.Any, local *eval.Env) error
+}
+
+func newPattern(item ast.Any) (pattern, error) {
+	switch pat := item.(type) {
+	default:
+		return nil, fmt.Errorf("bind expression contained non-pattern %#v", item)
+	case ast.Symbol:
+		return symbolPattern(pat), nil
+	case ast.Array:
+		return newParams(pat)
+	case ast.Map:
+		return newMapPattern(pat)
+	}
+}
+
+// symbol pattern binds any value
+type symbolPattern ast.Symbol
+
+func (pat symbolPattern) bind(val ast.Any, local *eval.Env) error {
+	local.Set(ast.Symbol(pat), val)
+	return nil
+}
+
+// array pattern or parameter list, eg. [a [b c] (d := 1) & more]
+type params struct {
+	src      ast.Array
+	required []pattern
+	optional []option
+	rest     pattern
+}
+
+// optional pattern with default expression
+type option struct {
+	pat pattern
+	def ast.Any
+}
+
+func newParams(binds ast.Array) (*params, error) {
+	p := &params{src: binds}
+	for i := 0; i < len(binds); i++ {
+		item := binds[i]
+		if isRest(item) {
+			if i != len(binds)-2 {
+				return nil, fmt.Errorf("bind expression wanted one pattern after & %#v", binds)
+			}
+			rest, err := newPattern(binds[i+1])
+			if err != nil {
+				return nil, err
+			}
+			p.rest = rest
+			break
+		}
+		if exp, ok := item.(ast.Expr); ok {
+			opt, err := newOption(exp)
+			if err != nil {
+				return nil, err
+			}
+			p.optional = append(p.optional, *opt)
+			continue
+		}
+		if len(p.optional) > 0 {
+			return nil, fmt.Errorf("bind expression contained required %#v after optional", item)
+		}
+		pat, err := newPattern(item)
+		if err != nil {
+			return nil, err
+		}
+		p.required = append(p.required, pat)
+	}
+	return p, nil
+}
+
+// optional pattern, eg. (c := 1)
+func newOption(exp ast.Expr) (*option, error) {
+	if len(exp) != 3 || !exp[0].Equal(ast.Symbol{Val: ":="}) {
+		return nil, fmt.Errorf("bind expression contained non-default %#v", exp)
+	}
+	pat, err := newPattern(exp[1])
+	if err != nil {
+		return nil, err
+	}
+	return &option{pat, exp[2]}, nil
+}
+
+func isRest(item ast.Any) bool {
+	return item.Equal(ast.Symbol{Val: "&"})
+}
+
+// accepted number of items, eg. "2", "1-3" or "at least 1"
+func (p *params) arity() string {
+	min := len(p.required)
+	max := min + len(p.optional)
+	switch {
+	case p.rest != nil:
+		return fmt.Sprintf("at least %d", min)
+	case min == max:
+		return fmt.Sprintf("%d", min)
+	default:
+		return fmt.Sprintf("%d-%d", min, max)
+	}
+}
+
+func (p *params) accepts(n int) bool {
+	return n >= len(p.required) && (p.rest != nil || n <= len(p.required)+len(p.optional))
+}
+
+// check the number of args against the parameter list
+func (p *params) check(exp ast.Expr) error {
+	if n := len(exp) - 1; !p.accepts(n) {
+		return fmt.Errorf("%v: wanted %s arg(s), got %d", exp[0], p.arity(), n)
+	}
+	return nil
+}
+
+func (p *params) bind(val ast.Any, local *eval.Env) error {
+	vals, ok := val.(ast.Array)
+	if !ok {
+		return fmt.Errorf("bind pattern %#v wanted array, got %#v", p.src, val)
+	}
+	if !p.accepts(len(vals)) {
+		return fmt.Errorf("bind pattern %#v wanted %s item(s), got %d", p.src, p.arity(), len(vals))
+	}
+	return p.bindArgs(vals, local)
+}
+
+// bind checked values to patterns in local, defaults are evaluated in local
+func (p *params) bindArgs(vals ast.Array, local *eval.Env) error {
+	for i, pat := range p.required {
+		if err := pat.bind(vals[i], local); err != nil {
+			return err
+		}
+	}
+	vals = vals[len(p.required):]
+	for _, opt := range p.optional {
+		if len(vals) > 0 {
+			if err := opt.pat.bind(vals[0], local); err != nil {
+				return err
+			}
+			vals = vals[1:]
+			continue
+		}
+		if err := opt.bindDefault(local); err != nil {
+			return err
+		}
+	}
+	if p.rest != nil {
+		rest := make(ast.Array, len(vals))
+		copy(rest, vals)
+		return p.rest.bind(rest, local)
+	}
+	return nil
+}
+
+func (opt option) bindDefault(local *eval.Env) error {
+	val, err := eval.Eval(opt.def, local)
+	if err != nil {
+		return err
+	}
+	return opt.pat.bind(val, local)
+}
+
+// map pattern, eg. {"name": n "tags": (tags := [])}
+type mapPattern struct {
+	src     ast.Map
+	keys    []ast.String
+	entries map[ast.String]option
+}
+
+func newMapPattern(binds ast.Map) (*mapPattern, error) {
+	// bind in a stable order
+	p := &mapPattern{src: binds, keys: binds.Keys(), entries: make(map[ast.String]option, len(binds))}
+	for key, item := range binds {
+		if exp, ok := item.(ast.Expr); ok {
+			opt, err := newOption(exp)
+			if err != nil {
+				return nil, err
+			}
+			p.entries[key] = *opt
+			continue
+		}
+		pat, err := newPattern(item)
+		if err != nil {
+			return nil, err
+		}
+		p.entries[key] = option{pat, nil}
+	}
+	return p, nil
+}
+
+func (p *mapPattern) bind(val ast.Any, local *eval.Env) error {
+	vals, ok := val.(ast.Map)
+	if !ok {
+		return fmt.Errorf("bind pattern %#v wanted map, got %#v", p.src, val)
+	}
+	for _, key := range p.keys {
+		entry := p.entries[key]
+		item, exists := vals[key]
+		if exists {
+			if err := entry.pat.bind(item, local); err != nil {
+				return err
+			}
+			continue
+		}
+		if entry.def == nil {
+			return fmt.Errorf("bind pattern %#v wanted key %#v", p.src, key)
+		}
+		if err := entry.bindDefault(local); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package lib
+
+import (
+	"fmt"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+func _quote(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	return exp[1], nil
+}
+
+func _quasiquote(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	return expand(exp[1], 1, env)
+}
+
+func _unquote(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	return ast.Null{}, fmt.Errorf("%v: called outside quasiquote", exp[0])
+}
+
+func _eval(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return eval.FutureEval(val, env), nil
+}
+
+func _macro(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	binds, ok := exp[1].(ast.Array)
+	if !ok {
+		return ast.Null{}, fmt.Errorf("called with non-array %#v", exp[1])
+	}
+	params, err := newParams(binds)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	body := exp[2]
+	fn := func(args ast.Expr, outer *eval.Env) (ast.Any, error) {
+		if err := params.check(args); err != nil {
+			return ast.Null{}, err
+		}
+		// bind unevaluated args
+		vals := make(ast.Array, len(args)-1)
+		copy(vals, args[1:])
+		local := eval.NewEnv(env)
+		if err := params.bindArgs(vals, local); err != nil {
+			return ast.Null{}, err
+		}
+		expansion, err := eval.Eval(body, local)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		// expansion in tail position, evaluated in the caller's scope
+		return eval.FutureEval(expansion, outer), nil
+	}
+	return eval.Func{Fn: fn, Name: "<macro>"}, nil
+}
+
+// expand a quasiquote template, unquoting only at depth 1 (the outermost quasiquote)
+func expand(any ast.Any, depth int, env *eval.Env) (ast.Any, error) {
+	switch tmpl := any.(type) {
+	default:
+		return any, nil
+	case ast.Expr:
+		switch {
+		case isQuote(tmpl, "unquote") && depth == 1:
+			return eval.Eval(tmpl[1], env)
+		case isQuote(tmpl, "unquote"), isQuote(tmpl, "unquote-splicing"):
+			return nested(tmpl, depth-1, env)
+		case isQuote(tmpl, "quasiquote"):
+			return nested(tmpl, depth+1, env)
+		}
+		res, err := expandAll(tmpl, depth, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		return ast.Expr(res), nil
+	case ast.Array:
+		res, err := expandAll(tmpl, depth, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		return ast.Array(res), nil
+	case ast.Map:
+		res := make(ast.Map, len(tmpl))
+		for _, key := range tmpl.Keys() {
+			val, err := expand(tmpl[key], depth, env)
+			if err != nil {
+				return ast.Null{}, err
+			}
+			res[key] = val
+		}
+		return res, nil
+	}
+}
+
+// (quote-form template), with the template expanded at depth
+func nested(exp ast.Expr, depth int, env *eval.Env) (ast.Any, error) {
+	val, err := expand(exp[1], depth, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Expr{exp[0], val}, nil
+}
+
+// expand items, splicing unquote-splicing results
+func expandAll(items []ast.Any, depth int, env *eval.Env) ([]ast.Any, error) {
+	res := make([]ast.Any, 0, len(items))
+	for _, item := range items {
+		if exp, ok := item.(ast.Expr); ok && isQuote(exp, "unquote-splicing") && depth == 1 {
+			val, err := eval.Eval(exp[1], env)
+			if err != nil {
+				return nil, err
+			}
+			switch seq := val.(type) {
+			default:
+				return nil, fmt.Errorf("%#v: called with non-array %#v", exp[0], val)
+			case ast.Array:
+				res = append(res, seq...)
+			case ast.Expr:
+				res = append(res, seq...)
+			}
+			continue
+		}
+		val, err := expand(item, depth, env)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return res, nil
+}
+
+// is exp (name any)
+func isQuote(exp ast.Expr, name string) bool {
+	return len(exp) == 2 && exp[0].Equal(ast.Symbol{Val: name})
+}
@@ -0,0 +1,195 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+	"github.com/shopspring/decimal"
+)
+
+var RationalLib = map[string]eval.FuncType{
+	"rational":    _rational,
+	"rational?":   isType("rational"),
+	"decimal":     _decimal,
+	"numerator":   _numerator,
+	"denominator": _denominator,
+}
+
+// Number or Rational
+func evalReal(exp ast.Any, env *eval.Env) (ast.Any, error) {
+	val, err := eval.Eval(exp, env)
+	if err != nil {
+		return nil, err
+	}
+	switch val.(type) {
+	default:
+		return nil, fmt.Errorf("called with non-number %#v", val)
+	case ast.Number, ast.Rational:
+		return val, nil
+	}
+}
+
+// eval reals, and whether any is a Rational
+func evalReals(exps []ast.Any, env *eval.Env) ([]ast.Any, bool, error) {
+	res := make([]ast.Any, len(exps))
+	exact := false
+	for i, item := range exps {
+		val, err := evalReal(item, env)
+		if err != nil {
+			return nil, false, err
+		}
+		if _, ok := val.(ast.Rational); ok {
+			exact = true
+		}
+		res[i] = val
+	}
+	return res, exact, nil
+}
+
+// exact big.Rat of a real
+func toRat(val ast.Any) *big.Rat {
+	switch num := val.(type) {
+	default:
+		panic(fmt.Sprintf("not a real %#v", val))
+	case ast.Number:
+		return num.Decimal().Rat()
+	case ast.Rational:
+		return num.Rat()
+	}
+}
+
+// decimal of a real, rounded by the numeric context
+func toDecimal(val ast.Any, num eval.Numeric) (decimal.Decimal, error) {
+	switch arg := val.(type) {
+	default:
+		panic(fmt.Sprintf("not a real %#v", val))
+	case ast.Number:
+		return arg.Decimal(), nil
+	case ast.Rational:
+		rat := arg.Rat()
+		return num.Div(decimal.NewFromBigInt(rat.Num(), 0), decimal.NewFromBigInt(rat.Denom(), 0))
+	}
+}
+
+// demote integers to Number
+func fromRat(rat *big.Rat, env *eval.Env) (ast.Any, error) {
+	if rat.IsInt() {
+		return checked(decimal.NewFromBigInt(rat.Num(), 0), env)
+	}
+	return ast.NewRational(rat), nil
+}
+
+// fold args with dec, or with rat when exact or any arg is Rational
+//
+// starts from the first arg when there are several, else from identity (eg. (- x) is 0 - x)
+func arith(exp ast.Expr, env *eval.Env, identity ast.Number, exact bool,
+	dec func(x, y decimal.Decimal) (decimal.Decimal, error),
+	rat func(x, y *big.Rat) (*big.Rat, error)) (ast.Any, error) {
+	args, anyRat, err := evalReals(exp[1:], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	var acc ast.Any = identity
+	if len(args) > 1 {
+		acc, args = args[0], args[1:]
+	}
+	if exact || anyRat {
+		res := toRat(acc)
+		for _, arg := range args {
+			res, err = rat(res, toRat(arg))
+			if err != nil {
+				return ast.Null{}, err
+			}
+		}
+		return fromRat(res, env)
+	}
+	res := acc.(ast.Number).Decimal()
+	for _, arg := range args {
+		res, err = dec(res, arg.(ast.Number).Decimal())
+		if err != nil {
+			return ast.Null{}, err
+		}
+	}
+	return checked(res, env)
+}
+
+// compare two reals exactly
+func compare(exp ast.Expr, env *eval.Env) (int, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return 0, err
+	}
+	args, anyRat, err := evalReals(exp[1:], env)
+	if err != nil {
+		return 0, err
+	}
+	if anyRat {
+		return toRat(args[0]).Cmp(toRat(args[1])), nil
+	}
+	return args[0].(ast.Number).Decimal().Cmp(args[1].(ast.Number).Decimal()), nil
+}
+
+// exact rational of a number, or a string (eg. "1/3")
+func _rational(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch arg := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("cannot convert %s %#v to rational", typeOf(val), val)
+	case ast.Number, ast.Rational:
+		return fromRat(toRat(arg), env)
+	case ast.String:
+		rat, err := ast.NewRationalFromString(arg.Val)
+		if err != nil {
+			return ast.Null{}, fmt.Errorf("cannot convert string %#v to rational", arg.Val)
+		}
+		return fromRat(rat.Rat(), env)
+	}
+}
+
+// decimal to N places, eg. (decimal 1/3r 4)
+func _decimal(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 3); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := evalReal(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	places, err := evalPlaces(exp[2], 0, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	num := env.Numeric()
+	num.Precision = places
+	res, err := toDecimal(val, num)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Number(num.Round(res)), nil
+}
+
+func _numerator(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := evalReal(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Number(decimal.NewFromBigInt(toRat(val).Num(), 0)), nil
+}
+
+func _denominator(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := exactLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	val, err := evalReal(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Number(decimal.NewFromBigInt(toRat(val).Denom(), 0)), nil
+}
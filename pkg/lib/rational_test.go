@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+)
+
+func TestRational(t *testing.T) {
+	evalsTo(t, []example{
+		{`1/3r`, `1/3r`},
+		{`-2/4r`, `-1/2r`},
+		{`4/2r`, `2`},
+		{`(1/3r + 1/6r)`, `1/2r`},
+		{`(1/3r * 3)`, `1`},
+		{`(1/3r - 0.5)`, `-1/6r`},
+		{`(with-numeric {"exact": true} [(1 / 3) (4 / 2) (1 / 4)])`, `[1/3r 2 1/4r]`},
+		{`[(rational 0.75) (rational "1/3") (rational "6/3")]`, `[3/4r 1/3r 2]`},
+		{`[(numerator 2/6r) (denominator 2/6r) (numerator 5)]`, `[1 3 5]`},
+		{`[(decimal 1/3r 4) (decimal 2/3r 0)]`, `[0.3333 1]`},
+		{`[(rational? 1/2r) (rational? 0.5)]`, `[true false]`},
+		{`[(1/3r == 2/6r) (1/2r == 0.5) (1/3r < 0.34)]`, `[true true true]`},
+		{`[(1/2r ** 3) (2/3r ** -2) (1/2r ** 0)]`, `[1/8r 9/4r 1]`},
+	})
+	failsWith(t, []example{
+		{`(rational "1/0")`, `cannot convert string`},
+		{`(1/3r / 0)`, `division by zero`},
+		{`(1/2r ** 1000000000000)`, `exact result exceeds 10000 digits`},
+		{`(1/2r ** 0.5)`, `non-integer`},
+		{`(decimal 1/3r -1)`, `places -1 out of range`},
+	})
+}
+
+func TestZeroRational(t *testing.T) {
+	var zero ast.Rational
+	if got := zero.String(); got != "0/1r" {
+		t.Errorf("got %s, want 0/1r", got)
+	}
+	if !zero.Equal(ast.NewRational(new(big.Rat))) || zero.Rat().Sign() != 0 {
+		t.Errorf("got %v, want 0", zero)
+	}
+}
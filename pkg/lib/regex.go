@@ -0,0 +1,165 @@
+package lib
+
+import (
+	"container/list"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+var RegexLib = map[string]eval.FuncType{
+	"re-match?":   _reMatchQ,
+	"re-find":     _reFind,
+	"re-find-all": _reFindAll,
+	"re-replace":  _reReplace,
+	"re-split":    _reSplit,
+}
+
+// compiled patterns, the most recently used by source
+var patterns = &patternCache{max: 128, items: map[string]*list.Element{}, order: list.New()}
+
+// bounded LRU cache of compiled patterns
+type patternCache struct {
+	mutex sync.Mutex
+	max   int
+	items map[string]*list.Element
+	// most recent first
+	order *list.List
+}
+
+func (cache *patternCache) get(pattern string) (*regexp.Regexp, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	item, ok := cache.items[pattern]
+	if !ok {
+		return nil, false
+	}
+	cache.order.MoveToFront(item)
+	return item.Value.(*regexp.Regexp), true
+}
+
+func (cache *patternCache) put(pattern string, re *regexp.Regexp) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if _, ok := cache.items[pattern]; ok {
+		return
+	}
+	cache.items[pattern] = cache.order.PushFront(re)
+	if cache.order.Len() > cache.max {
+		oldest := cache.order.Remove(cache.order.Back()).(*regexp.Regexp)
+		delete(cache.items, oldest.String())
+	}
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.get(pattern); ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.put(pattern, re)
+	return re, nil
+}
+
+// eval a pattern and n-1 string args, eg. (re-find #"\d+" s)
+func evalRegex(exp ast.Expr, env *eval.Env, n int) (*regexp.Regexp, []string, error) {
+	args, err := evalStrings(exp, env, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	re, err := compile(args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return re, args[1:], nil
+}
+
+// whole match as a string, or [match group...] with groups
+func submatch(re *regexp.Regexp, s string, loc []int) ast.Any {
+	if re.NumSubexp() == 0 {
+		return ast.String{Val: s[loc[0]:loc[1]]}
+	}
+	res := make(ast.Array, len(loc)/2)
+	for i := range res {
+		if loc[2*i] < 0 {
+			// group did not participate
+			res[i] = ast.Null{}
+			continue
+		}
+		res[i] = ast.String{Val: s[loc[2*i]:loc[2*i+1]]}
+	}
+	return res
+}
+
+// match anywhere in the string, use ^ and $ to anchor
+func _reMatchQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	re, args, err := evalRegex(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Boolean(re.MatchString(args[0])), nil
+}
+
+// first match, or null
+func _reFind(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	re, args, err := evalRegex(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	loc := re.FindStringSubmatchIndex(args[0])
+	if loc == nil {
+		return ast.Null{}, nil
+	}
+	return submatch(re, args[0], loc), nil
+}
+
+func _reFindAll(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	re, args, err := evalRegex(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	locs := re.FindAllStringSubmatchIndex(args[0], -1)
+	res := make(ast.Array, len(locs))
+	for i, loc := range locs {
+		res[i] = submatch(re, args[0], loc)
+	}
+	return res, nil
+}
+
+// replace all matches, expanding $1 or ${name} in the replacement
+func _reReplace(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	re, args, err := evalRegex(exp, env, 3)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: re.ReplaceAllString(args[0], args[1])}, nil
+}
+
+// (re-split pattern s) or (re-split pattern s limit)
+func _reSplit(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	re, args, err := evalRegex(exp[:3], env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	limit := -1
+	if len(exp) == 4 {
+		limit, err = evalInt(exp[3], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+	}
+	parts := re.Split(args[0], limit)
+	res := make(ast.Array, len(parts))
+	for i, part := range parts {
+		res[i] = ast.String{Val: part}
+	}
+	return res, nil
+}
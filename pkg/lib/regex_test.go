@@ -0,0 +1,51 @@
+package lib
+
+import (
+	"container/list"
+	"regexp"
+	"testing"
+)
+
+func TestRegex(t *testing.T) {
+	evalsTo(t, []example{
+		{`(re-match? #"\d+" "ab12")`, `true`},
+		{`(re-match? #"^\d+$" "ab12")`, `false`},
+		{`(re-find #"\d+" "ab12cd345")`, `"12"`},
+		{`(re-find #"(\w)(\d)?" "a")`, `["a" "a" null]`},
+		{`(re-find #"\d" "abc")`, `null`},
+		{`(re-find-all #"\d+" "ab12cd345")`, `["12" "345"]`},
+		{`(re-find-all #"(\w)=(\d)" "a=1 b=2")`, `[["a=1" "a" "1"] ["b=2" "b" "2"]]`},
+		{`(re-replace #"(\w+)@(\w+)" "me@host" "$2 at $1")`, `"host at me"`},
+		// ${1} would interpolate in a string, but not in a regex literal
+		{`(re-replace #"(?P<user>\w+)@" "me@host" #"${user} at ")`, `"me at host"`},
+		{`(re-split #"\s*,\s*" "a , b,c")`, `["a" "b" "c"]`},
+		{`(re-split #"," "a,b,c" 2)`, `["a" "b,c"]`},
+		// a regex literal is its pattern, without string escapes
+		{`#"\d+\."`, `"\\d+\\."`},
+		{`(re-match? "\\d" "1")`, `true`},
+	})
+	failsWith(t, []example{
+		{`(re-find "(" "x")`, `missing closing )`},
+		{`(re-find #"x" 1)`, `non-string`},
+	})
+}
+
+func TestPatternCache(t *testing.T) {
+	cache := &patternCache{max: 2, items: map[string]*list.Element{}, order: list.New()}
+	for _, pattern := range []string{"a", "b"} {
+		cache.put(pattern, regexp.MustCompile(pattern))
+	}
+	// a is now more recent than b, so c evicts b
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("a: not cached")
+	}
+	cache.put("c", regexp.MustCompile("c"))
+	for pattern, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := cache.get(pattern); ok != want {
+			t.Errorf("%s: cached %t, want %t", pattern, ok, want)
+		}
+	}
+	if n := cache.order.Len(); n != 2 {
+		t.Errorf("got %d cached patterns, want 2", n)
+	}
+}
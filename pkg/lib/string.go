@@ -0,0 +1,254 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+var StringLib = map[string]eval.FuncType{
+	"str": _str,
+	// interpolated strings, not rebindable
+	"${}":          _str,
+	"length":       _length,
+	"substring":    _substring,
+	"split":        _split,
+	"join":         _join,
+	"upper":        _upper,
+	"lower":        _lower,
+	"trim":         _trim,
+	"starts-with?": _startsWithQ,
+	"ends-with?":   _endsWithQ,
+	"replace":      _replace,
+	"format":       _format,
+}
+
+// eval args as strings
+func evalStrings(exp ast.Expr, env *eval.Env, n int) ([]string, error) {
+	if err := exactLen(exp, n+1); err != nil {
+		return nil, err
+	}
+	res := make([]string, n)
+	for i, item := range exp[1:] {
+		str, err := evalString(item, env)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = str.Val
+	}
+	return res, nil
+}
+
+// concatenate args as text, used by interpolated strings
+func _str(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	var b strings.Builder
+	for _, item := range exp[1:] {
+		val, err := eval.Eval(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		b.WriteString(val.String())
+	}
+	return ast.String{Val: b.String()}, nil
+}
+
+// length of a string in runes, or of an array or map
+func _length(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch coll := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("called with non-string or collection %#v", val)
+	case ast.String:
+		return ast.NewNumber(int64(utf8.RuneCountInString(coll.Val))), nil
+	case ast.Array:
+		return ast.NewNumber(int64(len(coll))), nil
+	case ast.Map:
+		return ast.NewNumber(int64(len(coll))), nil
+	}
+}
+
+// (substring s start) or (substring s start end), by rune index
+func _substring(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 3 && len(exp) != 4 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 2 or 3 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	str, err := evalString(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	runes := []rune(str.Val)
+	start, err := evalInt(exp[2], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	end := len(runes)
+	if len(exp) == 4 {
+		end, err = evalInt(exp[3], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+	}
+	if start < 0 || end > len(runes) || start > end {
+		return ast.Null{}, fmt.Errorf("range %d:%d out of range for length %d", start, end, len(runes))
+	}
+	return ast.String{Val: string(runes[start:end])}, nil
+}
+
+func _split(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	parts := strings.Split(args[0], args[1])
+	res := make(ast.Array, len(parts))
+	for i, part := range parts {
+		res[i] = ast.String{Val: part}
+	}
+	return res, nil
+}
+
+// (join xs) or (join xs sep)
+func _join(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if len(exp) != 2 && len(exp) != 3 {
+		return ast.Null{}, fmt.Errorf("%v: wanted 1 or 2 arg(s), got %d", exp[0], len(exp)-1)
+	}
+	arr, err := evalArray(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	sep := ""
+	if len(exp) == 3 {
+		str, err := evalString(exp[2], env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		sep = str.Val
+	}
+	parts := make([]string, len(arr))
+	for i, item := range arr {
+		parts[i] = item.String()
+	}
+	return ast.String{Val: strings.Join(parts, sep)}, nil
+}
+
+func _upper(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: strings.ToUpper(args[0])}, nil
+}
+
+func _lower(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: strings.ToLower(args[0])}, nil
+}
+
+func _trim(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 1)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: strings.TrimSpace(args[0])}, nil
+}
+
+func _startsWithQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Boolean(strings.HasPrefix(args[0], args[1])), nil
+}
+
+func _endsWithQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 2)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.Boolean(strings.HasSuffix(args[0], args[1])), nil
+}
+
+// replace all occurrences, eg. (replace s old new)
+func _replace(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	args, err := evalStrings(exp, env, 3)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: strings.ReplaceAll(args[0], args[1], args[2])}, nil
+}
+
+// printf-style formatting, eg. (format "%s: %.2f" name total)
+func _format(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	if err := minLen(exp, 2); err != nil {
+		return ast.Null{}, err
+	}
+	layout, err := evalString(exp[1], env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	args := make([]interface{}, len(exp)-2)
+	for i, item := range exp[2:] {
+		val, err := eval.Eval(item, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		args[i] = formatArg{val}
+	}
+	return ast.String{Val: fmt.Sprintf(layout.Val, args...)}, nil
+}
+
+// formats numbers exactly for integer and float verbs
+type formatArg struct {
+	val ast.Any
+}
+
+func (arg formatArg) Format(f fmt.State, verb rune) {
+	layout := directive(f, verb)
+	switch val := arg.val.(type) {
+	default:
+		fmt.Fprintf(f, layout, val.String())
+	case ast.String:
+		fmt.Fprintf(f, layout, val.Val)
+	case ast.Boolean:
+		fmt.Fprintf(f, layout, bool(val))
+	case ast.Number:
+		switch verb {
+		default:
+			fmt.Fprintf(f, layout, val.String())
+		case 'd', 'b', 'o', 'x', 'X':
+			fmt.Fprintf(f, layout, val.Decimal().BigInt())
+		case 'e', 'E', 'f', 'F', 'g', 'G':
+			num, _, _ := big.ParseFloat(val.String(), 10, 256, big.ToNearestEven)
+			fmt.Fprintf(f, layout, num)
+		}
+	}
+}
+
+// rebuild the %directive from its state
+func directive(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteRune('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		fmt.Fprintf(&b, "%d", width)
+	}
+	if prec, ok := f.Precision(); ok {
+		fmt.Fprintf(&b, ".%d", prec)
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
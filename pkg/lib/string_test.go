@@ -0,0 +1,36 @@
+package lib
+
+import "testing"
+
+func TestString(t *testing.T) {
+	evalsTo(t, []example{
+		{`(str "a" 1 [2] null)`, `"a1[2]null"`},
+		{`(length "héllo")`, `5`},
+		{`(length [1 2])`, `2`},
+		{`(substring "héllo" 1)`, `"éllo"`},
+		{`(substring "héllo" 1 3)`, `"él"`},
+		{`(split "a,b,,c" ",")`, `["a" "b" "" "c"]`},
+		{`(join ["a" "b" "c"])`, `"abc"`},
+		{`(join ["a" 1 "c"] ", ")`, `"a, 1, c"`},
+		{`(upper "abc")`, `"ABC"`},
+		{`(lower "ABC")`, `"abc"`},
+		{`(trim "  a b \n")`, `"a b"`},
+		{`(starts-with? "hello" "he")`, `true`},
+		{`(ends-with? "hello" "he")`, `false`},
+		{`(replace "a-b-c" "-" "+")`, `"a+b+c"`},
+		{`(format "%s: %.2f (%d)" "total" 1.005 42)`, `"total: 1.01 (42)"`},
+		{`(format "%v %q" [1] "x")`, `"[1] \"x\""`},
+		{`(format "%d" "x")`, `"%!d(string=x)"`},
+		// interpolation
+		{`(let [x 2] "x=${x}, x+1=${(x + 1)}")`, `"x=2, x+1=3"`},
+		{`(let [xs [1 2]] "${xs}\t${xs[0]}")`, `"[1 2]\t1"`},
+		{`"no ${"nested ${"strings"}"} here"`, `"no nested strings here"`},
+		{`"é\x41"`, `"éA"`},
+	})
+	failsWith(t, []example{
+		{`(substring "abc" 2 1)`, `out of`},
+		{`(substring "abc" 5)`, `out of range`},
+		{`(upper 1)`, `non-string`},
+		{`"${(1 / 0)}"`, `division by zero`},
+	})
+}
@@ -0,0 +1,142 @@
+package lib
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arizonahanson/oryx/pkg/ast"
+	"github.com/arizonahanson/oryx/pkg/eval"
+)
+
+var TypeLib = map[string]eval.FuncType{
+	"type-of":  _typeOf,
+	"null?":    isType("null"),
+	"boolean?": isType("boolean"),
+	"number?":  _numberQ,
+	"string?":  isType("string"),
+	"array?":   isType("array"),
+	"map?":     isType("map"),
+	"func?":    isType("function"),
+	"error?":   isType("error"),
+	"number":   _number,
+	"string":   _string,
+	"boolean":  _boolean,
+}
+
+// name of the type of a value
+func typeOf(val ast.Any) string {
+	switch val.(type) {
+	default:
+		return "unknown"
+	case ast.Null:
+		return "null"
+	case ast.Boolean:
+		return "boolean"
+	case ast.Number:
+		return "number"
+	case ast.Rational:
+		return "rational"
+	case ast.String:
+		return "string"
+	case ast.Array:
+		return "array"
+	case ast.Map:
+		return "map"
+	case ast.Symbol:
+		return "symbol"
+	case ast.Expr:
+		return "expression"
+	case ast.Error:
+		return "error"
+	case eval.Func:
+		return "function"
+	}
+}
+
+func _typeOf(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: typeOf(val)}, nil
+}
+
+// predicate for a type name
+func isType(name string) eval.FuncType {
+	return func(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+		val, err := oneArg(exp, env)
+		if err != nil {
+			return ast.Null{}, err
+		}
+		return ast.Boolean(typeOf(val) == name), nil
+	}
+}
+
+// number or rational
+func _numberQ(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch val.(type) {
+	default:
+		return ast.Boolean(false), nil
+	case ast.Number, ast.Rational:
+		return ast.Boolean(true), nil
+	}
+}
+
+// number, or string parsed as a number
+func _number(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	switch arg := val.(type) {
+	default:
+		return ast.Null{}, fmt.Errorf("cannot convert %s %#v to number", typeOf(val), val)
+	case ast.Number:
+		return arg, nil
+	case ast.Rational:
+		// rounded by the numeric context
+		res, err := toDecimal(arg, env.Numeric())
+		if err != nil {
+			return ast.Null{}, err
+		}
+		return ast.Number(res), nil
+	case ast.String:
+		num, err := ast.NewNumberFromString(strings.TrimSpace(arg.Val))
+		if err != nil {
+			return ast.Null{}, fmt.Errorf("cannot convert string %#v to number", arg.Val)
+		}
+		return num, nil
+	}
+}
+
+// printed form of any value, strings unchanged
+func _string(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	return ast.String{Val: val.String()}, nil
+}
+
+// "true" or "false" parsed, other values by truthiness
+func _boolean(exp ast.Expr, env *eval.Env) (ast.Any, error) {
+	val, err := oneArg(exp, env)
+	if err != nil {
+		return ast.Null{}, err
+	}
+	if str, ok := val.(ast.String); ok {
+		switch strings.TrimSpace(str.Val) {
+		default:
+			return ast.Null{}, fmt.Errorf("cannot convert string %#v to boolean", str.Val)
+		case "true":
+			return ast.Boolean(true), nil
+		case "false":
+			return ast.Boolean(false), nil
+		}
+	}
+	return ast.Boolean(truthy(val)), nil
+}
@@ -0,0 +1,27 @@
+package lib
+
+import "testing"
+
+func TestTypes(t *testing.T) {
+	evalsTo(t, []example{
+		{`(map type-of [null true 1 1/2r "s" [] {}])`, `["null" "boolean" "number" "rational" "string" "array" "map"]`},
+		{`(type-of type-of)`, `"function"`},
+		{`(type-of ([x] => x))`, `"function"`},
+		{`(type-of (error "e"))`, `"error"`},
+		{`[(null? null) (null? false)]`, `[true false]`},
+		{`[(boolean? false) (boolean? "true")]`, `[true false]`},
+		{`[(number? 1) (number? 1/2r) (number? "1")]`, `[true true false]`},
+		{`[(string? "") (array? []) (map? {}) (func? count) (error? (error "e"))]`, `[true true true true true]`},
+		{`(number " 1.50 ")`, `1.5`},
+		{`(number 1/4r)`, `0.25`},
+		{`(string [1 "a"])`, `"[1 \"a\"]"`},
+		{`(string "a")`, `"a"`},
+		{`[(boolean "true") (boolean " false") (boolean 0) (boolean null)]`, `[true false true false]`},
+	})
+	failsWith(t, []example{
+		{`(number "1x")`, `cannot convert string "1x" to number`},
+		{`(number [1])`, `cannot convert array`},
+		{`(boolean "yes")`, `cannot convert string "yes" to boolean`},
+		{`(type-of 1 2)`, `wanted 1 arg(s), got 2`},
+	})
+}